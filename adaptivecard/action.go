@@ -0,0 +1,231 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"strings"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+)
+
+// NewActionShowCard creates a new Action.ShowCard value using the provided
+// title and Card. The Card is shown to the user when the action is invoked.
+// An error is returned if invalid values are supplied.
+//
+// NOTE: Action types only deliverable via EndpointBot (e.g., Action.Submit)
+// are rejected within the Card; create the Action directly for bot delivery.
+func NewActionShowCard(title string, card Card) (Action, error) {
+	action := Action{
+		Type:  TypeActionShowCard,
+		Title: title,
+		Card:  &card,
+	}
+
+	err := action.Validate()
+	if err != nil {
+		return Action{}, err
+	}
+
+	return action, nil
+}
+
+// NewActionSubmit creates a new Action.Submit value using the provided title
+// and (optional) data. The data is combined with the values of input fields
+// when the action is invoked.
+//
+// NOTE: Action.Submit is not deliverable via webhook and is rejected by
+// default validation; see Message.ValidateForEndpoint and EndpointBot.
+func NewActionSubmit(title string, data interface{}) Action {
+	return Action{
+		Type:  TypeActionSubmit,
+		Title: title,
+		Data:  data,
+	}
+}
+
+// NewActionExecute creates a new Action.Execute value using the provided
+// title, verb and (optional) data. The data is combined with the values of
+// input fields when the action is invoked.
+//
+// NOTE: Action.Execute requires a card Version of at least
+// ActionExecuteMinCardVersionRequired.
+func NewActionExecute(title string, verb string, data interface{}) Action {
+	return Action{
+		Type:  TypeActionExecute,
+		Title: title,
+		Verb:  verb,
+		Data:  data,
+	}
+}
+
+// assertActionPayloadFields asserts that the Data, Verb and AssociatedInputs
+// fields are only set for the Action types which support them.
+func assertActionPayloadFields(actionType string, data interface{}, verb string, associatedInputs string) error {
+	gathersInputs := actionType == TypeActionSubmit || actionType == TypeActionExecute
+
+	switch {
+	case data != nil && !gathersInputs:
+		return fmt.Errorf(
+			"field Data is not supported for action type %s;"+
+				" expected %s or %s: %w",
+			actionType,
+			TypeActionSubmit,
+			TypeActionExecute,
+			ErrInvalidFieldValue,
+		)
+
+	case associatedInputs != "" && !gathersInputs:
+		return fmt.Errorf(
+			"field AssociatedInputs is not supported for action type %s;"+
+				" expected %s or %s: %w",
+			actionType,
+			TypeActionSubmit,
+			TypeActionExecute,
+			ErrInvalidFieldValue,
+		)
+
+	case verb != "" && actionType != TypeActionExecute:
+		return fmt.Errorf(
+			"field Verb is not supported for action type %s; expected %s: %w",
+			actionType,
+			TypeActionExecute,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
+
+// assertShowCardNestingDepth asserts that an Action.ShowCard action has a
+// Card and that nested Action.ShowCard actions do not exceed
+// ActionShowCardMaxNestingDepth.
+func assertShowCardNestingDepth(a Action) error {
+	if a.Card == nil {
+		return fmt.Errorf(
+			"required field Card is empty for action type %s: %w",
+			a.Type,
+			ErrMissingValue,
+		)
+	}
+
+	if depth := showCardNestingDepth(a); depth > ActionShowCardMaxNestingDepth {
+		return fmt.Errorf(
+			"nesting depth %d of %s action %q exceeds limit of %d: %w",
+			depth,
+			a.Type,
+			a.Title,
+			ActionShowCardMaxNestingDepth,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
+
+// showCardNestingDepth returns the depth of nested Action.ShowCard actions
+// for the given action. An Action.ShowCard action without nested
+// Action.ShowCard actions has a depth of 1, all other action types have a
+// depth of 0.
+func showCardNestingDepth(a Action) int {
+	if a.Type != TypeActionShowCard || a.Card == nil {
+		return 0
+	}
+
+	var maxDepth int
+
+	// The WalkFunc never returns an error.
+	_ = a.Card.Walk(func(node Node) error {
+		if node.Action == nil || node.Action.Type != TypeActionShowCard {
+			return nil
+		}
+
+		// Each enclosing Action.ShowCard adds a card segment to the path.
+		if depth := strings.Count(node.Path, "/card/") + 1; depth > maxDepth {
+			maxDepth = depth
+		}
+
+		return nil
+	})
+
+	return maxDepth + 1
+}
+
+// cardActionTypes returns the type of every Action and ISelectAction used by
+// the given Card (see Card.Walk), including fallback actions and those used
+// by the Cards of Action.ShowCard actions.
+func cardActionTypes(c Card) []string {
+	var types []string
+
+	// The WalkFunc never returns an error.
+	_ = c.walkNodes(func(node Node) error {
+		switch {
+		case node.Action != nil:
+			types = append(types, node.Action.Type)
+		case node.SelectAction != nil:
+			types = append(types, node.SelectAction.Type)
+		}
+
+		return nil
+	})
+
+	return types
+}
+
+// assertActionsDeliverable asserts that the Actions used by the given Card
+// are deliverable through the specified Endpoint.
+func assertActionsDeliverable(c Card, endpoint Endpoint) error {
+	deliverable := supportedEndpointActionValues(endpoint)
+
+	for _, actionType := range cardActionTypes(c) {
+		if !goteamsnotify.InList(actionType, deliverable, false) {
+			return fmt.Errorf(
+				"action type %s is not supported for %s delivery;"+
+					" supported action types are %q: %w",
+				actionType,
+				endpoint,
+				deliverable,
+				ErrActionNotDeliverable,
+			)
+		}
+	}
+
+	return nil
+}
+
+// withoutEndpointActionTypeErrors removes the violations of the given
+// validation error which reject an Action type deliverable through the
+// specified Endpoint (e.g., Action.Submit via EndpointBot). Action types
+// only valid for some endpoints are rejected by default validation. nil is
+// returned if no other violations remain.
+func withoutEndpointActionTypeErrors(err error, endpoint Endpoint) error {
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	deliverable := supportedEndpointActionValues(endpoint)
+	remaining := make(ValidationErrors, 0, len(errs))
+
+	for _, validationErr := range errs {
+		actionType, _ := validationErr.Value.(string)
+
+		if validationErr.Field == "Type" && validationErr.Rule == ValidationRuleOneOf &&
+			goteamsnotify.InList(actionType, deliverable, false) {
+			continue
+		}
+
+		remaining = append(remaining, validationErr)
+	}
+
+	if len(remaining) == 0 {
+		return nil
+	}
+
+	return remaining
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestActionValidation(t *testing.T) {
+	nestedShowCard := func(depth int) Action {
+		card := NewCard()
+		card.Body = []Element{NewTextBlock("innermost", false)}
+
+		var action Action
+		for i := 0; i < depth; i++ {
+			inner := card
+			action = Action{Type: TypeActionShowCard, Title: "more", Card: &inner}
+			card = NewCard()
+			card.Actions = []Action{action}
+		}
+
+		return action
+	}
+
+	openURL, err := NewActionOpenURL("https://example.com", "open")
+	if err != nil {
+		t.Fatalf("unexpected error creating action: %v", err)
+	}
+	openURL.Verb = "approve"
+
+	showCard, err := NewActionShowCard("details", NewCard())
+	if err != nil {
+		t.Fatalf("unexpected error creating action: %v", err)
+	}
+	showCard.Style = ActionStylePositive
+
+	tests := []struct {
+		name    string
+		action  Action
+		wantErr error
+	}{
+		{name: "execute", action: NewActionExecute("Approve", "approve", map[string]string{"id": "42"})},
+		{name: "submit", action: NewActionSubmit("Send", nil), wantErr: ErrInvalidType},
+		{name: "showcard", action: showCard},
+		{name: "showcard missing card", action: Action{Type: TypeActionShowCard}, wantErr: ErrMissingValue},
+		{name: "showcard max depth", action: nestedShowCard(ActionShowCardMaxNestingDepth)},
+		{name: "showcard too deep", action: nestedShowCard(ActionShowCardMaxNestingDepth + 1), wantErr: ErrInvalidFieldValue},
+		{name: "verb on openurl", action: openURL, wantErr: ErrInvalidFieldValue},
+		{name: "invalid style", action: Action{Type: TypeActionExecute, Verb: "approve", Style: "loud"}, wantErr: ErrInvalidFieldValue},
+		{name: "invalid associated inputs", action: Action{Type: TypeActionExecute, Verb: "approve", AssociatedInputs: "all"}, wantErr: ErrInvalidFieldValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.action.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestActionVersionAndEndpointValidation(t *testing.T) {
+	newMessage := func(version string, actions ...Action) *Message {
+		card := NewCard()
+		card.Version = version
+		card.Body = []Element{NewTextBlock("body", false)}
+		card.Actions = actions
+
+		msg, err := NewMessageFromCard(card)
+		if err != nil {
+			t.Fatalf("unexpected error creating message: %v", err)
+		}
+
+		return msg
+	}
+
+	execute := NewActionExecute("Approve", "approve", nil)
+
+	if err := newMessage("1.4", execute).Validate(); err != nil {
+		t.Errorf("unexpected error for Action.Execute with version 1.4: %v", err)
+	}
+
+	if err := newMessage("1.3", execute).Validate(); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("got error %v; want %v", err, ErrUnsupportedFeature)
+	}
+
+	// Action.Submit nested within the card of an Action.ShowCard.
+	nested := NewCard()
+	nested.Actions = []Action{NewActionSubmit("Send", nil)}
+	showCard := Action{Type: TypeActionShowCard, Title: "Reply", Card: &nested}
+
+	msg := newMessage("1.4", showCard)
+
+	// Default validation applies the webhook endpoint checks.
+	if err := msg.Validate(); !errors.Is(err, ErrActionNotDeliverable) {
+		t.Errorf("got error %v; want %v", err, ErrActionNotDeliverable)
+	}
+
+	if err := msg.ValidateForEndpoint(EndpointWebhook); !errors.Is(err, ErrActionNotDeliverable) {
+		t.Errorf("got error %v; want %v", err, ErrActionNotDeliverable)
+	}
+
+	if err := msg.ValidateForEndpoint(EndpointBot); err != nil {
+		t.Errorf("unexpected error for bot endpoint: %v", err)
+	}
+
+	if err := msg.ValidateForEndpoint("email"); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
 	"github.com/atc0005/go-teams-notify/v2/internal/validator"
@@ -72,6 +73,52 @@ const (
 	// defaultMentionTextSeparator is the default separator used between the
 	// contents of the Mention.Text field and a TextBlock.Text field.
 	defaultMentionTextSeparator string = " "
+
+	// MentionTextRegex is the pattern used to find Mention.Text values
+	// (e.g., "<at>Jane</at>") in the text of Card elements.
+	MentionTextRegex string = "<at>[^<]+</at>"
+
+	// ConversationIDRegex is the pattern used to validate the ID of a
+	// mentioned channel or team (e.g., "19:abc123@thread.tacv2").
+	ConversationIDRegex string = `^19:[^@\s]+@thread\.(skype|tacv2|v2)$`
+)
+
+// Mentioned types. The Mentioned.Type field is empty for user, channel and
+// team mentions.
+//
+//   - https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/conversations/channel-and-group-conversations#tag-mention
+//   - https://learn.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/cards-format#mention-support-within-adaptive-cards
+const (
+	// MentionedTypeTag indicates that a tag (e.g., "sre-oncall") is
+	// mentioned; all members of the tag are notified.
+	MentionedTypeTag string = "tag"
+)
+
+// Conversation identity types of mentioned channels and teams. The
+// Mentioned.ConversationIdentityType field is empty for user and tag
+// mentions.
+//
+//   - https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/conversations/channel-and-group-conversations#channel-and-team-mention
+const (
+	// ConversationIdentityTypeChannel indicates that a channel is
+	// mentioned.
+	ConversationIdentityTypeChannel string = "channel"
+
+	// ConversationIdentityTypeTeam indicates that a team is mentioned.
+	ConversationIdentityTypeTeam string = "team"
+)
+
+// Mention reference prefixes used by ResolveMentions. A mention reference
+// consists of an optional prefix and an ID (e.g., "tag:<tag ID>").
+const (
+	MentionRefPrefixUser    string = "user"
+	MentionRefPrefixTag     string = MentionedTypeTag
+	MentionRefPrefixChannel string = ConversationIdentityTypeChannel
+	MentionRefPrefixTeam    string = ConversationIdentityTypeTeam
+
+	// MentionRefSeparator separates the prefix and ID of a mention
+	// reference.
+	MentionRefSeparator string = ":"
 )
 
 // Attachment constants.
@@ -159,11 +206,42 @@ const (
 	ColorAttention string = "attention"
 )
 
+// Font type for TextBlock or TextRun elements.
+const (
+	FontTypeDefault   string = "default"
+	FontTypeMonospace string = "monospace"
+)
+
 // Image specific constants.
 // https://adaptivecards.io/explorer/Image.html
 const (
-	ImageStyleDefault string = ""
-	ImageStylePerson  string = ""
+	ImageStyleDefault string = "default"
+	ImageStylePerson  string = "person"
+
+	// ImageBackgroundColorRegex is a regular expression pattern intended to
+	// match the hex color values (e.g., "#DDDDDD" or "#FFDDDDDD") supported
+	// by the BackgroundColor field of an Image element.
+	ImageBackgroundColorRegex string = "^#([0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"
+)
+
+// Image size values for the Size field of an Image element or the ImageSize
+// field of an ImageSet element.
+//
+// https://adaptivecards.io/explorer/Image.html
+// https://adaptivecards.io/explorer/ImageSet.html
+const (
+	ImageSizeAuto    string = "auto"
+	ImageSizeStretch string = "stretch"
+	ImageSizeSmall   string = "small"
+	ImageSizeMedium  string = "medium"
+	ImageSizeLarge   string = "large"
+)
+
+// Keyword height values for the Height field of an Image element. A specific
+// pixel height (e.g., "50px") is also supported.
+const (
+	ImageHeightAuto    string = "auto"
+	ImageHeightStretch string = "stretch"
 )
 
 // ChoiceInput specific constants.
@@ -171,6 +249,37 @@ const (
 	ChoiceInputStyleCompact  string = "compact"
 	ChoiceInputStyleExpanded string = "expanded"
 	ChoiceInputStyleFiltered string = "filtered" // Introduced in version 1.5
+
+	// ChoiceInputValueSeparator is the separator used between the values of
+	// selected choices for an Input.ChoiceSet element with multi-select
+	// enabled.
+	ChoiceInputValueSeparator string = ","
+)
+
+// DateInput and TimeInput specific constants.
+//
+//   - https://adaptivecards.io/explorer/Input.Date.html
+//   - https://adaptivecards.io/explorer/Input.Time.html
+const (
+	// DateInputFormat is the layout (as used by the time package) of the
+	// Value, Min and Max fields of an Input.Date element.
+	DateInputFormat string = "2006-01-02"
+
+	// TimeInputFormat is the layout (as used by the time package) of the
+	// Value, Min and Max fields of an Input.Time element.
+	TimeInputFormat string = "15:04"
+)
+
+// ToggleInput specific constants.
+// https://adaptivecards.io/explorer/Input.Toggle.html
+const (
+	// ToggleInputValueOnDefault is the value of an Input.Toggle element when
+	// toggled on if the ValueOn field is not set.
+	ToggleInputValueOnDefault string = "true"
+
+	// ToggleInputValueOffDefault is the value of an Input.Toggle element when
+	// toggled off if the ValueOff field is not set.
+	ToggleInputValueOffDefault string = "false"
 )
 
 // TextInput specific constants.
@@ -219,6 +328,15 @@ const (
 	VerticalAlignmentBottom string = "bottom"
 )
 
+// Supported FillMode values for a BackgroundImage.
+// https://adaptivecards.io/explorer/BackgroundImage.html
+const (
+	BackgroundImageFillModeCover              string = "cover"
+	BackgroundImageFillModeRepeatHorizontally string = "repeatHorizontally"
+	BackgroundImageFillModeRepeatVertically   string = "repeatVertically"
+	BackgroundImageFillModeRepeat             string = "repeat"
+)
+
 // Supported width values for the msteams property used in in Adaptive Card
 // messages sent via Microsoft Teams.
 const (
@@ -281,6 +399,62 @@ const (
 	TypeActionToggleVisibility string = "Action.ToggleVisibility"
 )
 
+// Supported Action styles.
+// https://adaptivecards.io/explorer/Action.OpenUrl.html
+const (
+	ActionStyleDefault     string = "default"
+	ActionStylePositive    string = "positive"
+	ActionStyleDestructive string = "destructive"
+)
+
+// Supported AssociatedInputs values for the Action.Submit and Action.Execute
+// types.
+const (
+	// AssociatedInputsAuto indicates that inputs on the current card and any
+	// parent cards are validated and submitted with the action.
+	AssociatedInputsAuto string = "auto"
+
+	// AssociatedInputsNone indicates that no inputs are validated or
+	// submitted with the action.
+	AssociatedInputsNone string = "none"
+)
+
+// RefreshMaxUserIDs is the maximum number of user IDs supported by Microsoft
+// Teams for the UserIDs field of a card Refresh.
+//
+// https://learn.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/universal-actions-for-adaptive-cards/user-specific-views
+const RefreshMaxUserIDs int = 60
+
+// LangRegex is a regular expression pattern intended to match the language
+// values supported by the Lang field of a Card, either an ISO-639-1 code
+// (e.g., "en") or one qualified by region (e.g., "en-US").
+const LangRegex string = "^[a-zA-Z]{2}(-[a-zA-Z0-9]{2,8})*$"
+
+// ActionShowCardMaxNestingDepth is the maximum supported depth of nested
+// Action.ShowCard actions (i.e., a ShowCard action in the card of another
+// ShowCard action). Deeply nested cards are difficult to navigate and do not
+// render consistently across Teams clients; this limit is intentionally
+// conservative.
+const ActionShowCardMaxNestingDepth int = 3
+
+// Endpoint identifies the type of endpoint used to deliver a Message. Not all
+// Action types are deliverable through all endpoint types.
+type Endpoint string
+
+// Supported Endpoint values.
+const (
+	// EndpointWebhook indicates delivery via an O365 connector or Power
+	// Automate Workflow webhook URL, as used by the TeamsClient type provided
+	// by this project. Action.Submit is not supported for webhook delivery.
+	//
+	// https://docs.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/cards-reference#support-for-adaptive-cards
+	EndpointWebhook Endpoint = "webhook"
+
+	// EndpointBot indicates delivery via a bot (e.g., Bot Framework) or the
+	// Microsoft Graph API. All Action types are supported.
+	EndpointBot Endpoint = "bot"
+)
+
 // Supported Fallback options.
 const (
 	TypeFallbackActionExecute          string = TypeActionExecute
@@ -295,6 +469,25 @@ const (
 	TypeFallbackOptionDrop string = "drop"
 )
 
+// Requires property values. The requires property of an element or action is
+// a map of host features to the minimum version of the feature required.
+//
+// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/fallback-and-requires
+const (
+	// RequiresFeatureAdaptiveCards is the name of the feature representing
+	// the Adaptive Card schema version supported by the host.
+	RequiresFeatureAdaptiveCards string = "adaptiveCards"
+
+	// RequiresAnyVersion indicates that any version of a feature satisfies
+	// the requirement.
+	RequiresAnyVersion string = "*"
+
+	// RequiresVersionRegex is the pattern used to validate the feature
+	// version values of the requires property; a version consists of up to
+	// four dot-separated numeric components (e.g., "1.2").
+	RequiresVersionRegex string = `^[0-9]+(\.[0-9]+){0,3}$`
+)
+
 // Valid types for an Adaptive Card element. Not all types are supported by
 // Microsoft Teams.
 //
@@ -343,6 +536,39 @@ var (
 
 	// ErrValueNotFound indicates that a requested value was not found.
 	ErrValueNotFound = errors.New("requested value not found")
+
+	// ErrActionNotDeliverable indicates that a valid Action type is not
+	// deliverable through the chosen Endpoint (e.g., Action.Submit via
+	// webhook). This error wraps ErrInvalidType.
+	ErrActionNotDeliverable = fmt.Errorf("action type not deliverable via endpoint: %w", ErrInvalidType)
+
+	// ErrUnsupportedFeature indicates that a Card uses a feature introduced
+	// in a later schema version than the declared card Version. This error
+	// wraps ErrInvalidFieldValue.
+	ErrUnsupportedFeature = fmt.Errorf("feature requires later card version: %w", ErrInvalidFieldValue)
+
+	// ErrDuplicateID indicates that an ID is used by more than one element,
+	// column or action of a Card. This error wraps ErrInvalidFieldValue.
+	ErrDuplicateID = fmt.Errorf("duplicate ID: %w", ErrInvalidFieldValue)
+
+	// ErrTargetNotFound indicates that a TargetElement refers to an ID not
+	// used by any element or column of a Card. This error wraps
+	// ErrValueNotFound.
+	ErrTargetNotFound = fmt.Errorf("target element not found: %w", ErrValueNotFound)
+
+	// ErrSchemaViolation indicates that a JSON payload does not conform to
+	// the Adaptive Card JSON schema. This error wraps ErrInvalidFieldValue.
+	ErrSchemaViolation = fmt.Errorf("schema violation: %w", ErrInvalidFieldValue)
+
+	// ErrTemplateExpansion indicates that a card or message template could
+	// not be parsed, expanded or rendered (e.g., due to an invalid
+	// expression).
+	ErrTemplateExpansion = errors.New("template expansion failed")
+
+	// ErrSkipChildren is used as a return value from a WalkFunc to indicate
+	// that the children of the current node are to be skipped. It is not
+	// returned as an error by Card.Walk.
+	ErrSkipChildren = errors.New("skip children of node")
 )
 
 // Message represents a Microsoft Teams message containing one or more
@@ -367,9 +593,26 @@ type Message struct {
 	// validation is performed.
 	ValidateFunc func() error `json:"-"`
 
-	// payload is a prepared Message in JSON format for submission or pretty
-	// printing.
-	payload *bytes.Buffer `json:"-"`
+	// ValidationRules is an optional registry of custom validation rules
+	// applied to each attached card in addition to default validation. The
+	// rules are not applied if ValidateFunc is specified.
+	ValidationRules *ValidationRules `json:"-"`
+
+	// DisableHTMLEscape controls whether the characters <, > and & are left
+	// as-is when generating the JSON payload for the Message. By default
+	// these characters are escaped (e.g., "<at>" becomes "\u003cat\u003e").
+	// Disabling escaping results in smaller and more readable payloads.
+	DisableHTMLEscape bool `json:"-"`
+
+	// payload holds the prepared Message payload ([]byte) in JSON format for
+	// submission or pretty printing. The payload is stored atomically per
+	// value.
+	payload atomic.Value `json:"-"`
+
+	// UnknownFields holds the JSON properties of the Message which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Message.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // Attachments is a collection of Adaptive Cards for a Microsoft Teams
@@ -394,6 +637,11 @@ type Attachment struct {
 	//
 	// TODO: Should this be a pointer?
 	Content TopLevelCard `json:"content"`
+
+	// UnknownFields holds the JSON properties of the Attachment which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Attachment.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // TopLevelCard represents the outer or top-level Card for a Microsoft Teams
@@ -412,7 +660,7 @@ type Card struct {
 	Type string `json:"type"`
 
 	// Schema represents the URI of the Adaptive Card schema.
-	Schema string `json:"$schema"`
+	Schema string `json:"$schema,omitempty"`
 
 	// Version is required for top-level cards (i.e., the outer card in an
 	// attachment); the schema version that the content for an Adaptive Card
@@ -449,13 +697,9 @@ type Card struct {
 	// MSTeams is a container for properties specific to Microsoft Teams
 	// messages, including formatting properties and user mentions.
 	//
-	// NOTE: Using pointer in order to omit unused field from JSON output.
+	// NOTE: The omitempty option has no effect for struct fields; an empty
+	// MSTeams value is omitted from JSON output by Card.MarshalJSON.
 	// https://stackoverflow.com/questions/18088294/how-to-not-marshal-an-empty-struct-into-json-with-go
-	// MSTeams *MSTeams `json:"msteams,omitempty"`
-	//
-	// TODO: Revisit this and use a pointer if remote API doesn't like
-	// receiving an empty object, though brief testing doesn't show this to be
-	// a problem.
 	MSTeams MSTeams `json:"msteams,omitempty"`
 
 	// MinHeight specifies the minimum height of the card.
@@ -466,6 +710,116 @@ type Card struct {
 	// or cards with a minHeight specified. If MinHeight field is specified,
 	// this field is required.
 	VerticalContentAlignment string `json:"verticalContentAlignment,omitempty"`
+
+	// SelectAction is an Action that will be invoked when the card is tapped
+	// or selected. Action.ShowCard is not supported. Introduced in version
+	// 1.1.
+	SelectAction *ISelectAction `json:"selectAction,omitempty"`
+
+	// BackgroundImage specifies the background image of the card. Setting
+	// fields other than URL requires version 1.2.
+	BackgroundImage *BackgroundImage `json:"backgroundImage,omitempty"`
+
+	// Speak specifies what should be spoken for this entire card. This is
+	// simple text or SSML fragment.
+	Speak string `json:"speak,omitempty"`
+
+	// Lang is the 2-letter ISO-639-1 language used in the card (e.g., "en").
+	// Used to localize any date/time functions.
+	Lang string `json:"lang,omitempty"`
+
+	// Rtl, when true, draws the content of the card right-to-left.
+	// Introduced in version 1.5.
+	Rtl *bool `json:"rtl,omitempty"`
+
+	// Refresh defines how the card can be refreshed by making a request to
+	// the target Bot. Introduced in version 1.4.
+	//
+	// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/universal-action-model#refresh-mechanism
+	Refresh *Refresh `json:"refresh,omitempty"`
+
+	// Authentication defines authentication information to enable on-behalf-of
+	// single sign on or just-in-time OAuth. Introduced in version 1.4.
+	Authentication *Authentication `json:"authentication,omitempty"`
+
+	// UnknownFields holds the JSON properties of the Card which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card.
+	UnknownFields map[string]json.RawMessage `json:"-"`
+}
+
+// Refresh defines how a card can be refreshed by making a request to the
+// target Bot.
+//
+// https://adaptivecards.io/explorer/Refresh.html
+type Refresh struct {
+	// Action is required; the Action.Execute action invoked to refresh the
+	// card.
+	Action *Action `json:"action"`
+
+	// UserIDs is a list of user IDs informing the client for which users
+	// the card should automatically refresh. Microsoft Teams supports up to
+	// RefreshMaxUserIDs values.
+	UserIDs []string `json:"userIds,omitempty"`
+}
+
+// Authentication defines authentication information associated with a card.
+// This maps to the OAuthCard type defined by the Bot Framework.
+//
+// https://adaptivecards.io/explorer/Authentication.html
+type Authentication struct {
+	// Text is the text that can be displayed to the end user when prompting
+	// them to authenticate.
+	Text string `json:"text,omitempty"`
+
+	// ConnectionName is the identifier for registered OAuth connection
+	// setting information.
+	ConnectionName string `json:"connectionName,omitempty"`
+
+	// TokenExchangeResource provides information required to enable
+	// on-behalf-of single sign-on user authentication.
+	TokenExchangeResource *TokenExchangeResource `json:"tokenExchangeResource,omitempty"`
+
+	// Buttons are the buttons that should be displayed to the user when
+	// prompting for authentication.
+	Buttons []AuthCardButton `json:"buttons,omitempty"`
+}
+
+// TokenExchangeResource defines information required to enable
+// on-behalf-of single sign-on user authentication.
+//
+// https://adaptivecards.io/explorer/TokenExchangeResource.html
+type TokenExchangeResource struct {
+	// ID is required; the unique identified of this token exchange instance.
+	ID string `json:"id"`
+
+	// URI is required; an application ID or resource identifier with which
+	// to exchange a token on behalf of.
+	URI string `json:"uri"`
+
+	// ProviderID is required; an identifier for the identity provider with
+	// which to attempt a token exchange.
+	ProviderID string `json:"providerId"`
+}
+
+// AuthCardButton defines a button as displayed when prompting a user to
+// authenticate.
+//
+// https://adaptivecards.io/explorer/AuthCardButton.html
+type AuthCardButton struct {
+	// Type is required; the type of the button (e.g., "signin").
+	Type string `json:"type"`
+
+	// Title is the caption of the button.
+	Title string `json:"title,omitempty"`
+
+	// Image is the URL of an image to display alongside the button's
+	// caption.
+	Image string `json:"image,omitempty"`
+
+	// Value is required; the value associated with the button. The meaning
+	// of value depends on the button's type.
+	Value string `json:"value"`
 }
 
 // Elements is a collection of Element values.
@@ -502,12 +856,57 @@ type Element struct {
 	// https://adaptivecards.io/explorer/ImageSet.html
 	URL string `json:"url,omitempty"`
 
-	// Size controls the size of text within a TextBlock element.
+	// AltText is alternate text describing an Image element for
+	// accessibility purposes.
+	AltText string `json:"altText,omitempty"`
+
+	// BackgroundColor applies a background to a transparent image for an
+	// Image element. This field respects the image style.
+	//
+	// The value is expected to match ImageBackgroundColorRegex (e.g.,
+	// "#DDDDDD").
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+
+	// Width is the desired on-screen width of an Image element in pixels
+	// (e.g., "50px"). This overrides the Size field.
+	Width string `json:"width,omitempty"`
+
+	// Height is the desired height of an Image element. Valid values are
+	// ImageHeightAuto, ImageHeightStretch or a specific pixel height (e.g.,
+	// "50px"). A pixel height overrides the Size field.
+	Height string `json:"height,omitempty"`
+
+	// Images is required for the ImageSet element type. Images is the
+	// collection of Image elements to display.
+	//
+	// https://adaptivecards.io/explorer/ImageSet.html
+	Images []Element `json:"images,omitempty"`
+
+	// ImageSize controls the approximate size of each image in an ImageSet
+	// element. The physical dimensions will vary per host. Valid values are
+	// the same as the Size field of an Image element except for
+	// ImageSizeStretch.
+	ImageSize string `json:"imageSize,omitempty"`
+
+	// Size controls the size of text within a TextBlock element or the
+	// approximate size of an Image element. Valid values differ based on the
+	// element type.
 	Size string `json:"size,omitempty"`
 
 	// Weight controls the weight of text in TextBlock or TextRun elements.
 	Weight string `json:"weight,omitempty"`
 
+	// FontType controls the type of font used for text in a TextBlock
+	// element. Introduced in version 1.2.
+	FontType string `json:"fontType,omitempty"`
+
+	// Inlines is required for the RichTextBlock element type. Inlines is the
+	// collection of TextRun values rendered (in order) as a single block of
+	// text. Introduced in version 1.2.
+	//
+	// https://adaptivecards.io/explorer/RichTextBlock.html
+	Inlines []TextRun `json:"inlines,omitempty"`
+
 	// Color controls the color of TextBlock elements or text used in TextRun
 	// elements.
 	Color string `json:"color,omitempty"`
@@ -586,7 +985,7 @@ type Element struct {
 	// element is tapped or selected. Action.ShowCard is not supported.
 	//
 	// This field is used by supported Container element types (Column,
-	// ColumnSet, Container).
+	// ColumnSet, Container) and the Image element type.
 	//
 	SelectAction *ISelectAction `json:"selectAction,omitempty"`
 
@@ -609,6 +1008,29 @@ type Element struct {
 	// drawn at the top of the element.
 	Separator bool `json:"separator,omitempty"`
 
+	// Bleed determines whether the element should bleed through its parent's
+	// padding. This field is used by the Container and ColumnSet element
+	// types.
+	Bleed bool `json:"bleed,omitempty"`
+
+	// MinHeight specifies the minimum height of the element in pixels (e.g.,
+	// "80px"). This field is used by the Container and ColumnSet element
+	// types. If specified for a Container, VerticalContentAlignment is
+	// required.
+	MinHeight string `json:"minHeight,omitempty"`
+
+	// VerticalContentAlignment defines how the content should be aligned
+	// vertically within a Container element.
+	VerticalContentAlignment string `json:"verticalContentAlignment,omitempty"`
+
+	// BackgroundImage specifies a background image for a Container element.
+	BackgroundImage *BackgroundImage `json:"backgroundImage,omitempty"`
+
+	// Rtl, when true, draws the content of a Container element
+	// right-to-left. When not specified, the value is inherited from the
+	// parent container or card.
+	Rtl *bool `json:"rtl,omitempty"`
+
 	// CodeSnippet provides the content for a CodeBlock element, specific to MSTeams.
 	CodeSnippet string `json:"codeSnippet,omitempty"`
 
@@ -617,6 +1039,168 @@ type Element struct {
 
 	// StartLineNumber specifies the initial line number of CodeBlock element, specific to MSTeams.
 	StartLineNumber int `json:"startLineNumber,omitempty"`
+
+	// Label is the label for an Input element. Labels are shown above the
+	// input and are announced by accessibility software. Introduced in
+	// version 1.3.
+	//
+	// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/input-validation
+	Label string `json:"label,omitempty"`
+
+	// IsRequired indicates whether a value is required for an Input element
+	// before an action which gathers inputs is permitted. Introduced in
+	// version 1.3.
+	IsRequired bool `json:"isRequired,omitempty"`
+
+	// ErrorMessage is the error message displayed when the value entered for
+	// an Input element is invalid. Introduced in version 1.3.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// Placeholder is the text displayed when no value has been entered for
+	// an Input.Text, Input.Number, Input.Date, Input.Time or (compact)
+	// Input.ChoiceSet element.
+	Placeholder string `json:"placeholder,omitempty"`
+
+	// Value is the initial value for an Input element. A number is expected
+	// for the Input.Number element type, a string for all other Input
+	// element types.
+	//
+	// For an Input.Date element the value is expected in DateInputFormat and
+	// for an Input.Time element the value is expected in TimeInputFormat. For
+	// an Input.ChoiceSet element with IsMultiSelect enabled, multiple values
+	// are separated by ChoiceInputValueSeparator.
+	Value interface{} `json:"value,omitempty"`
+
+	// Min is the minimum value permitted for an Input.Number (number),
+	// Input.Date (string) or Input.Time (string) element.
+	Min interface{} `json:"min,omitempty"`
+
+	// Max is the maximum value permitted for an Input.Number (number),
+	// Input.Date (string) or Input.Time (string) element.
+	Max interface{} `json:"max,omitempty"`
+
+	// IsMultiline indicates whether multiple lines of text are permitted for
+	// an Input.Text element.
+	IsMultiline bool `json:"isMultiline,omitempty"`
+
+	// MaxLength is a hint for the maximum length of the value entered for an
+	// Input.Text element.
+	MaxLength int `json:"maxLength,omitempty"`
+
+	// Regex is a regular expression used to validate the value entered for an
+	// Input.Text element. Introduced in version 1.3.
+	Regex string `json:"regex,omitempty"`
+
+	// InlineAction is an action rendered inline with an Input.Text element
+	// (e.g., a "send" button). Introduced in version 1.2.
+	InlineAction *ISelectAction `json:"inlineAction,omitempty"`
+
+	// Choices is the collection of Choice values for an Input.ChoiceSet
+	// element.
+	Choices []Choice `json:"choices,omitempty"`
+
+	// IsMultiSelect indicates whether multiple choices may be selected for
+	// an Input.ChoiceSet element.
+	IsMultiSelect bool `json:"isMultiSelect,omitempty"`
+
+	// Title is required for the Input.Toggle element type; the title text
+	// displayed next to the toggle.
+	Title string `json:"title,omitempty"`
+
+	// ValueOn is the value of an Input.Toggle element when toggled on. If not
+	// specified, ToggleInputValueOnDefault is used.
+	ValueOn string `json:"valueOn,omitempty"`
+
+	// ValueOff is the value of an Input.Toggle element when toggled off. If
+	// not specified, ToggleInputValueOffDefault is used.
+	ValueOff string `json:"valueOff,omitempty"`
+
+	// Fallback describes what to do when the element type is unknown to the
+	// host or the Requires of the element can't be met; the element is
+	// either dropped or replaced with a fallback element. Introduced in
+	// version 1.2.
+	Fallback *ElementFallback `json:"fallback,omitempty"`
+
+	// Requires is a map of host features (e.g., RequiresFeatureAdaptiveCards)
+	// to the minimum version of the feature required to render the element.
+	// RequiresAnyVersion matches any version of a feature. Introduced in
+	// version 1.2.
+	Requires map[string]string `json:"requires,omitempty"`
+
+	// UnknownFields holds the JSON properties of the Element which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the Element.
+	UnknownFields map[string]json.RawMessage `json:"-"`
+}
+
+// TextRuns is a collection of TextRun values.
+type TextRuns []TextRun
+
+// TextRun is a block of text inside a RichTextBlock element. Unlike a
+// TextBlock, formatting is applied to the TextRun as a whole and markdown is
+// not supported. Introduced in version 1.2.
+//
+// https://adaptivecards.io/explorer/TextRun.html
+type TextRun struct {
+	// Type is required; must be set to "TextRun".
+	Type string `json:"type"`
+
+	// Text is required; the text to display. Markdown is not supported.
+	Text string `json:"text"`
+
+	// Color controls the color of the text.
+	Color string `json:"color,omitempty"`
+
+	// FontType controls the type of font used for the text.
+	FontType string `json:"fontType,omitempty"`
+
+	// Size controls the size of the text.
+	Size string `json:"size,omitempty"`
+
+	// Weight controls the weight of the text.
+	Weight string `json:"weight,omitempty"`
+
+	// Highlight indicates whether the text should be highlighted.
+	Highlight bool `json:"highlight,omitempty"`
+
+	// IsSubtle indicates whether the text should appear slightly toned down.
+	IsSubtle bool `json:"isSubtle,omitempty"`
+
+	// Italic indicates whether the text should be italicized.
+	Italic bool `json:"italic,omitempty"`
+
+	// Strikethrough indicates whether the text should be struck through.
+	Strikethrough bool `json:"strikethrough,omitempty"`
+
+	// Underline indicates whether the text should be underlined. Introduced
+	// in version 1.3.
+	Underline bool `json:"underline,omitempty"`
+
+	// SelectAction is an Action that will be invoked when the text is tapped
+	// or selected. Action.ShowCard is not supported.
+	SelectAction *ISelectAction `json:"selectAction,omitempty"`
+
+	// UnknownFields holds the JSON properties of the TextRun which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the TextRun.
+	UnknownFields map[string]json.RawMessage `json:"-"`
+}
+
+// Choices is a collection of Choice values.
+type Choices []Choice
+
+// Choice represents an item that can be selected from an Input.ChoiceSet
+// element.
+//
+// https://adaptivecards.io/explorer/Input.Choice.html
+type Choice struct {
+	// Title is required; the text displayed for the choice.
+	Title string `json:"title"`
+
+	// Value is required; the raw value of the choice.
+	Value string `json:"value"`
 }
 
 // Container is an Element type that allows grouping items together.
@@ -681,6 +1265,74 @@ type Column struct {
 	// setting at the table level. When not specified, vertical alignment is
 	// defined at the table, row or cell level.
 	VerticalCellContentAlignment string `json:"verticalCellContentAlignment,omitempty"`
+
+	// Style is the style used for the Column (e.g., "emphasis").
+	Style string `json:"style,omitempty"`
+
+	// Bleed determines whether the column should bleed through its parent's
+	// padding.
+	Bleed bool `json:"bleed,omitempty"`
+
+	// Spacing controls the amount of spacing between this column and the
+	// preceding column.
+	Spacing string `json:"spacing,omitempty"`
+
+	// Separator, when true, indicates that a separating line should be drawn
+	// at the left of the column.
+	Separator bool `json:"separator,omitempty"`
+
+	// MinHeight specifies the minimum height of the column in pixels (e.g.,
+	// "80px"). If specified, VerticalContentAlignment is required.
+	MinHeight string `json:"minHeight,omitempty"`
+
+	// VerticalContentAlignment defines how the content should be aligned
+	// vertically within the column.
+	VerticalContentAlignment string `json:"verticalContentAlignment,omitempty"`
+
+	// BackgroundImage specifies a background image for the column.
+	BackgroundImage *BackgroundImage `json:"backgroundImage,omitempty"`
+
+	// Visible specifies whether this column will be removed from the visual
+	// tree.
+	//
+	// If not specified defaults to true.
+	//
+	// NOTE: We define this field as a pointer type so that omitting a value
+	// for the pointer leaves the field out of the generated JSON payload (due
+	// to 'omitempty' behavior of the JSON encoder and results in the
+	// "defaults to true" behavior as defined by the schema.
+	Visible *bool `json:"isVisible,omitempty"`
+
+	// Rtl, when true, draws the content of the column right-to-left. When
+	// not specified, the value is inherited from the parent container or
+	// card.
+	Rtl *bool `json:"rtl,omitempty"`
+
+	// UnknownFields holds the JSON properties of the Column which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the Column.
+	UnknownFields map[string]json.RawMessage `json:"-"`
+}
+
+// BackgroundImage specifies a background image for a Card, Container or
+// Column. Acceptable formats are PNG, JPEG, and GIF.
+//
+// https://adaptivecards.io/explorer/BackgroundImage.html
+type BackgroundImage struct {
+	// URL is required; the URL (or data URL) of the image.
+	URL string `json:"url"`
+
+	// FillMode describes how the image should fill the area.
+	FillMode string `json:"fillMode,omitempty"`
+
+	// HorizontalAlignment describes how the image should be aligned if it
+	// must be cropped or if using repeat fill mode.
+	HorizontalAlignment string `json:"horizontalAlignment,omitempty"`
+
+	// VerticalAlignment describes how the image should be aligned if it must
+	// be cropped or if using repeat fill mode.
+	VerticalAlignment string `json:"verticalAlignment,omitempty"`
 }
 
 // Facts is a collection of Fact values.
@@ -736,6 +1388,12 @@ type TableCell struct {
 	// Items are the card elements that should be rendered inside of the
 	// cell.
 	Items []*Element `json:"items,omitempty"`
+
+	// UnknownFields holds the JSON properties of the TableCell which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the TableCell.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // TableCells is a collection of TableCell values.
@@ -773,6 +1431,12 @@ type TableRow struct {
 	// there are columns defined on the Table element, the extra cells are
 	// ignored.
 	Cells []TableCell `json:"cells"`
+
+	// UnknownFields holds the JSON properties of the TableRow which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the TableRow.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // TableRows is a collection of TableRow values.
@@ -817,8 +1481,23 @@ type Action struct {
 
 	// Fallback describes what to do when an unknown element is encountered or
 	// the requirements of this or any children can't be met.
+	//
+	// Use TypeFallbackOptionDrop to drop the action or FallbackAction to
+	// specify a replacement action.
 	Fallback string `json:"fallback,omitempty"`
 
+	// FallbackAction is the action rendered in place of this action when the
+	// action type is unknown to the host or the Requires of the action can't
+	// be met. This value is encoded as the fallback property and may not be
+	// used with the Fallback field. Introduced in version 1.2.
+	FallbackAction *Action `json:"-"`
+
+	// Requires is a map of host features (e.g., RequiresFeatureAdaptiveCards)
+	// to the minimum version of the feature required to render the action.
+	// RequiresAnyVersion matches any version of a feature. Introduced in
+	// version 1.2.
+	Requires map[string]string `json:"requires,omitempty"`
+
 	// Card property is used by Action.ShowCard type.
 	//
 	// NOTE: Based on a review of JSON content, it looks like `ActionCard` is
@@ -827,6 +1506,43 @@ type Action struct {
 	// refs https://github.com/matthidinger/ContosoScubaBot/blob/master/Cards/SubscriberNotification.JSON
 	Card *Card `json:"card,omitempty"`
 
+	// Style controls the style of the Action, which affects how the action
+	// is displayed, spoken, etc. Introduced in version 1.2.
+	Style string `json:"style,omitempty"`
+
+	// IconURL is an optional icon to be shown on the action in conjunction
+	// with the title. Introduced in version 1.1.
+	IconURL string `json:"iconUrl,omitempty"`
+
+	// Tooltip is displayed when hovering over the action. Introduced in
+	// version 1.5.
+	Tooltip string `json:"tooltip,omitempty"`
+
+	// IsEnabled controls the enabled state of the action. A disabled action
+	// cannot be clicked. Introduced in version 1.5.
+	//
+	// If not specified defaults to true.
+	//
+	// NOTE: We define this field as a pointer type so that omitting a value
+	// for the pointer leaves the field out of the generated JSON payload (due
+	// to 'omitempty' behavior of the JSON encoder and results in the
+	// "defaults to true" behavior as defined by the schema.
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+
+	// Data is initial data that input fields will be combined with. These
+	// are essentially "hidden" properties. This field is used by the
+	// Action.Submit and Action.Execute types.
+	Data interface{} `json:"data,omitempty"`
+
+	// Verb is the card author-defined verb associated with this action. This
+	// field is used by the Action.Execute type.
+	Verb string `json:"verb,omitempty"`
+
+	// AssociatedInputs controls which inputs are associated with the action.
+	// This field is used by the Action.Submit and Action.Execute types.
+	// Introduced in version 1.3.
+	AssociatedInputs string `json:"associatedInputs,omitempty"`
+
 	// TargetElements is the collection of TargetElement values.
 	//
 	// It is not recommended to include Input elements with validation due to
@@ -835,6 +1551,26 @@ type Action struct {
 	//
 	// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/input-validation
 	TargetElements []TargetElement `json:"targetElements,omitempty"`
+
+	// UnknownFields holds the JSON properties of the Action which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Action.
+	UnknownFields map[string]json.RawMessage `json:"-"`
+}
+
+// ElementFallback describes what to do when an element type is unknown to
+// the host or the requirements of an element can't be met. Either Drop or
+// Element should be set.
+//
+// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/fallback-and-requires
+type ElementFallback struct {
+	// Drop indicates that the element should be dropped. This value is
+	// encoded as TypeFallbackOptionDrop.
+	Drop bool
+
+	// Element is rendered in place of the unsupported element. Fallback
+	// elements may specify a Fallback of their own.
+	Element *Element
 }
 
 // TargetElement represents an entry for Action.ToggleVisibility's
@@ -930,8 +1666,23 @@ type ISelectAction struct {
 
 	// Fallback describes what to do when an unknown element is encountered or
 	// the requirements of this or any children can't be met.
+	//
+	// Use TypeFallbackOptionDrop to drop the action or FallbackAction to
+	// specify a replacement action.
 	Fallback string `json:"fallback,omitempty"`
 
+	// FallbackAction is the action used in place of this action when the
+	// action type is unknown to the host or the Requires of the action can't
+	// be met. This value is encoded as the fallback property and may not be
+	// used with the Fallback field. Introduced in version 1.2.
+	FallbackAction *ISelectAction `json:"-"`
+
+	// Requires is a map of host features (e.g., RequiresFeatureAdaptiveCards)
+	// to the minimum version of the feature required to use the action.
+	// RequiresAnyVersion matches any version of a feature. Introduced in
+	// version 1.2.
+	Requires map[string]string `json:"requires,omitempty"`
+
 	// TargetElements is the collection of TargetElement values.
 	//
 	// This field is specific to the Action.ToggleVisibility Action type.
@@ -942,6 +1693,31 @@ type ISelectAction struct {
 	//
 	// https://docs.microsoft.com/en-us/adaptive-cards/authoring-cards/input-validation
 	TargetElements []TargetElement `json:"targetElements,omitempty"`
+
+	// Tooltip is displayed when hovering over the action. Introduced in
+	// version 1.5.
+	Tooltip string `json:"tooltip,omitempty"`
+
+	// IsEnabled controls the enabled state of the action. If not specified
+	// defaults to true. Introduced in version 1.5.
+	IsEnabled *bool `json:"isEnabled,omitempty"`
+
+	// Data is initial data that input fields will be combined with. This
+	// field is used by the Action.Submit and Action.Execute types.
+	Data interface{} `json:"data,omitempty"`
+
+	// Verb is the card author-defined verb associated with this action. This
+	// field is used by the Action.Execute type.
+	Verb string `json:"verb,omitempty"`
+
+	// AssociatedInputs controls which inputs are associated with the action.
+	// This field is used by the Action.Submit and Action.Execute types.
+	AssociatedInputs string `json:"associatedInputs,omitempty"`
+
+	// UnknownFields holds the JSON properties of the ISelectAction which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the ISelectAction.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // MSTeams represents a container for properties specific to Microsoft Teams
@@ -962,12 +1738,19 @@ type MSTeams struct {
 	// Entities is a collection of user mentions.
 	// TODO: Should this be a slice of pointers?
 	Entities []Mention `json:"entities,omitempty"`
+
+	// UnknownFields holds the JSON properties of the MSTeams which are not
+	// recognized by this package (e.g., as decoded by ParseMessage). These
+	// properties are included when encoding the Card or Message containing
+	// the MSTeams.
+	UnknownFields map[string]json.RawMessage `json:"-"`
 }
 
 // Mentions is a collection of Mention values.
 type Mentions []Mention
 
-// Mention represents a mention in the message for a specific user.
+// Mention represents a mention in the message for a specific user, tag,
+// channel or team.
 type Mention struct {
 	// Type is required; must be set to "mention".
 	Type string `json:"type"`
@@ -979,19 +1762,33 @@ type Mention struct {
 	// HERE</at> tags.
 	Text string `json:"text"`
 
-	// Mentioned represents a user that is mentioned.
+	// Mentioned represents a user, tag, channel or team that is mentioned.
 	Mentioned Mentioned `json:"mentioned"`
 }
 
-// Mentioned represents the user id and name of a user that is mentioned.
+// Mentioned represents the id and name of a user, tag, channel or team that
+// is mentioned.
 type Mentioned struct {
 	// ID is the unique identifier for a user that is mentioned. This value
 	// can be an object ID (e.g., 5e8b0f4d-2cd4-4e17-9467-b0f6a5c0c4d0) or a
 	// UserPrincipalName (e.g., NewUser@contoso.onmicrosoft.com).
+	//
+	// For tag mentions this is the ID of the tag, for channel and team
+	// mentions the conversation ID (e.g., 19:abc123@thread.tacv2).
 	ID string `json:"id"`
 
-	// Name is the DisplayName of the user mentioned.
+	// Name is the DisplayName of the user, tag, channel or team mentioned.
 	Name string `json:"name"`
+
+	// Type indicates the type of the entity mentioned (e.g.,
+	// MentionedTypeTag). This field is empty for user, channel and team
+	// mentions.
+	Type string `json:"type,omitempty"`
+
+	// ConversationIdentityType indicates the type of the conversation
+	// mentioned (e.g., ConversationIdentityTypeChannel). This field is empty
+	// for user and tag mentions.
+	ConversationIdentityType string `json:"conversationIdentityType,omitempty"`
 }
 
 // NewMessage creates a new Message with required fields predefined.
@@ -1115,9 +1912,9 @@ func (m *Message) Carousel() *Message {
 // PrettyPrint returns a formatted JSON payload of the Message if the
 // Prepare() method has been called, or an empty string otherwise.
 func (m *Message) PrettyPrint() string {
-	if m.payload != nil {
+	if payload := m.preparedPayload(); payload != nil {
 		var prettyJSON bytes.Buffer
-		_ = json.Indent(&prettyJSON, m.payload.Bytes(), "", "\t")
+		_ = json.Indent(&prettyJSON, payload, "", "\t")
 
 		return prettyJSON.String()
 	}
@@ -1127,46 +1924,102 @@ func (m *Message) PrettyPrint() string {
 
 // Prepare handles tasks needed to construct a payload from a Message for
 // delivery to an endpoint.
+//
+// Prepare records the payload within the Message. The goteamsnotify client
+// coordinates its calls to Prepare and Validate, so the same Message may be
+// submitted to several webhooks concurrently.
 func (m *Message) Prepare() error {
-	jsonMessage, err := json.Marshal(m)
+	payload, err := m.PayloadSnapshot()
 	if err != nil {
-		return fmt.Errorf(
-			"error marshalling Message to JSON: %w",
-			err,
-		)
+		return err
 	}
 
-	switch {
-	case m.payload == nil:
-		m.payload = &bytes.Buffer{}
-	default:
-		m.payload.Reset()
-	}
+	m.payload.Store(payload)
 
-	_, err = m.payload.Write(jsonMessage)
+	return nil
+}
+
+// PayloadSnapshot returns a newly generated JSON payload for the Message
+// without modifying the Message. The returned payload is not shared with the
+// Message or other callers.
+func (m *Message) PayloadSnapshot() ([]byte, error) {
+	jsonMessage, err := m.marshalJSON(!m.DisableHTMLEscape)
 	if err != nil {
-		return fmt.Errorf(
-			"error updating JSON payload for Message: %w",
+		return nil, fmt.Errorf(
+			"error marshalling Message to JSON: %w",
 			err,
 		)
 	}
 
-	return nil
+	return jsonMessage, nil
 }
 
 // Payload returns the prepared Message payload. The caller should call
 // Prepare() prior to calling this method, results are undefined otherwise.
+//
+// A new reader is returned for each call; reading from one does not affect
+// others.
 func (m *Message) Payload() io.Reader {
-	return m.payload
+	payload := m.preparedPayload()
+	if payload == nil {
+		return nil
+	}
+
+	return bytes.NewReader(payload)
+}
+
+// preparedPayload returns the payload recorded by Prepare or nil if the
+// Message has not been prepared.
+func (m *Message) preparedPayload() []byte {
+	payload, _ := m.payload.Load().([]byte)
+
+	return payload
 }
 
 // Validate performs validation for Message using ValidateFunc if defined,
-// otherwise applying default validation.
+// otherwise applying default validation for delivery via EndpointWebhook
+// (see ValidateForEndpoint).
 func (m Message) Validate() error {
 	if m.ValidateFunc != nil {
 		return m.ValidateFunc()
 	}
 
+	return m.ValidateForEndpoint(EndpointWebhook)
+}
+
+// ValidateForEndpoint applies default validation for Message, asserting that
+// all Actions used are deliverable through the specified Endpoint. An error
+// wrapping ErrActionNotDeliverable is returned for Action types not
+// supported by the Endpoint (e.g., Action.Submit via EndpointWebhook).
+// Action types which are only valid for some endpoints (e.g., Action.Submit
+// via EndpointBot) are accepted if supported by the Endpoint. Custom
+// ValidationRules are applied if specified; ValidateFunc is not used.
+func (m Message) ValidateForEndpoint(endpoint Endpoint) error {
+	v := validator.Validator{}
+
+	v.InList(
+		string(endpoint),
+		"Endpoint",
+		"message",
+		supportedEndpointValues(),
+		ErrInvalidFieldValue,
+	)
+
+	for _, attachment := range m.Attachments {
+		content := attachment.Content.Card
+		v.SuccessfulFuncCall(
+			func() error { return assertActionsDeliverable(content, endpoint) },
+		)
+	}
+
+	v.SuccessfulFuncCall(func() error { return m.validate(endpoint) })
+
+	return v.Err()
+}
+
+// validate applies default validation for Message, accepting the Action
+// types deliverable through the specified Endpoint.
+func (m Message) validate(endpoint Endpoint) error {
 	v := validator.Validator{}
 
 	v.FieldHasSpecificValue(
@@ -1181,7 +2034,17 @@ func (m Message) Validate() error {
 	// to generate a valid Message for Microsoft Teams delivery.
 	v.NotEmptyCollection("Attachments", m.Type, ErrMissingValue, m.Attachments)
 
-	v.SelfValidate(Attachments(m.Attachments))
+	for _, attachment := range m.Attachments {
+		attachment := attachment
+		v.SuccessfulFuncCall(
+			func() error {
+				return withoutEndpointActionTypeErrors(
+					attachment.validateWithRules(m.ValidationRules),
+					endpoint,
+				)
+			},
+		)
+	}
 
 	// Optional field, but only specific values permitted if set.
 	v.InListIfFieldValNotEmpty(
@@ -1195,8 +2058,17 @@ func (m Message) Validate() error {
 	return v.Err()
 }
 
-// Validate asserts that fields have valid values.
+// Validate asserts that fields have valid values. The features used by the
+// attached card are validated against the card versions at which Microsoft
+// Teams renders them (see TeamsFeatureVersion).
 func (a Attachment) Validate() error {
+	return a.validateWithRules(nil)
+}
+
+// validateWithRules asserts that fields have valid values (see
+// Attachment.Validate) and that the attached card satisfies the given custom
+// validation rules, if any.
+func (a Attachment) validateWithRules(rules *ValidationRules) error {
 	v := validator.Validator{}
 
 	v.FieldHasSpecificValue(
@@ -1207,7 +2079,9 @@ func (a Attachment) Validate() error {
 		ErrInvalidType,
 	)
 
-	v.SelfValidate(a.Content)
+	v.SuccessfulFuncCall(
+		func() error { return a.Content.ValidateWithRules(rules) },
+	)
 
 	return v.Err()
 }
@@ -1223,10 +2097,36 @@ func (a Attachments) Validate() error {
 	return nil
 }
 
-// Validate asserts that fields have valid values.
+// Validate asserts that fields have valid values. The features used by the
+// Card are validated against the card versions at which Microsoft Teams
+// renders them (see TeamsFeatureVersion).
 func (c Card) Validate() error {
 	v := validator.Validator{}
 
+	c.validateProperties(&v)
+
+	v.SelfValidate(Elements(c.Body))
+	v.SelfValidate(Actions(c.Actions))
+
+	if c.SelectAction != nil {
+		v.SelfValidate(c.SelectAction)
+	}
+
+	if c.Refresh != nil {
+		v.SelfValidate(c.Refresh)
+	}
+
+	// Elements, Actions and properties introduced in later Adaptive Card
+	// schema versions than the one declared for this card are unsupported.
+	v.SuccessfulFuncCall(func() error { return assertFeaturesSupportedByCardVersion(c) })
+
+	return v.Err()
+}
+
+// validateProperties asserts that the fields of the Card itself have valid
+// values using the given Validator. Body elements and actions are not
+// validated.
+func (c Card) validateProperties(v *validator.Validator) {
 	// TODO: Version field validation
 	//
 	// The Version field is required for top-level cards, optional for Cards
@@ -1268,19 +2168,39 @@ func (c Card) Validate() error {
 		},
 	)
 
-	v.SelfValidate(Elements(c.Body))
-	v.SelfValidate(Actions(c.Actions))
+	if c.BackgroundImage != nil {
+		v.SelfValidate(c.BackgroundImage)
+	}
 
-	return v.Err()
+	if c.Authentication != nil {
+		v.SelfValidate(c.Authentication)
+	}
+
+	v.SuccessfulFuncCall(func() error { return assertValidLangOrEmptyValue(c.Lang) })
 }
 
 // Validate asserts that fields have valid values.
+//
+// If validation fails, every element, column, text run, action and card of
+// the Card tree (see Card.Walk) is validated and a ValidationErrors value is
+// returned which records the violations found along with their location.
 func (tc TopLevelCard) Validate() error {
+	err := tc.validate()
+	if err == nil {
+		return nil
+	}
+
+	return collectValidationErrors(tc, err)
+}
+
+// validate asserts that fields have valid values. Only the first violation
+// is reported.
+func (tc TopLevelCard) validate() error {
 	v := validator.Validator{}
 
 	// Validate embedded Card first as those validation requirements apply
 	// here also.
-	v.SelfValidate(tc.Card)
+	v.SuccessfulFuncCall(tc.Card.Validate)
 
 	// The Version field is required for top-level cards (this one), optional
 	// for Cards nested within an Action.ShowCard.
@@ -1288,6 +2208,12 @@ func (tc TopLevelCard) Validate() error {
 		func() error { return assertValidVersionFieldValue(tc.Version) },
 	)
 
+	// IDs are scoped to the top-level card, including the Cards nested
+	// within an Action.ShowCard.
+	v.SuccessfulFuncCall(
+		func() error { return assertCardIDIntegrity(tc.Card) },
+	)
+
 	return v.Err()
 }
 
@@ -1306,8 +2232,20 @@ func (e Elements) Validate() error {
 func (e Element) Validate() error {
 	v := validator.Validator{}
 
+	e.validate(&v)
+
+	// Return the last recorded validation error, or nil if no validation
+	// errors occurred.
+	return v.Err()
+}
+
+// validate applies the validation checks of Element.Validate using the given
+// Validator.
+func (e Element) validate(v *validator.Validator) {
 	supportedElementTypes := supportedElementTypes()
-	supportedSizeValues := supportedSizeValues()
+	// Valid Size field values also differ based on type; an Image element
+	// supports a different set of size values than a TextBlock.
+	supportedSizeValues := supportedSizeValues(e.Type)
 	supportedWeightValues := supportedWeightValues()
 	supportedColorValues := supportedColorValues()
 	supportedSpacingValues := supportedSpacingValues()
@@ -1327,9 +2265,16 @@ func (e Element) Validate() error {
 	v.InListIfFieldValNotEmpty(e.Size, "Size", "element", supportedSizeValues, ErrInvalidFieldValue)
 	v.InListIfFieldValNotEmpty(e.Weight, "Weight", "element", supportedWeightValues, ErrInvalidFieldValue)
 	v.InListIfFieldValNotEmpty(e.Color, "Color", "element", supportedColorValues, ErrInvalidFieldValue)
+	v.InListIfFieldValNotEmpty(e.FontType, "FontType", "element", supportedFontTypeValues(), ErrInvalidFieldValue)
 	v.InListIfFieldValNotEmpty(e.Spacing, "Spacing", "element", supportedSpacingValues, ErrInvalidFieldValue)
 	v.InListIfFieldValNotEmpty(e.HorizontalAlignment, "HorizontalAlignment", "element", supportedHorizontalAlignmentValues, ErrInvalidFieldValue)
 	v.InListIfFieldValNotEmpty(e.Style, "Style", "element", supportedStyleValues, ErrInvalidFieldValue)
+	v.SuccessfulFuncCall(func() error { return assertValidRequires(e.Requires) })
+
+	if e.Fallback != nil {
+		fallback := *e.Fallback
+		v.SuccessfulFuncCall(func() error { return fallback.validate(v.SkipNested) })
+	}
 
 	/******************************************************************
 		Requirements for specific Element types.
@@ -1345,32 +2290,69 @@ func (e Element) Validate() error {
 	// Columns collection is used by the ColumnSet type. While not required,
 	// the collection should be checked.
 	case e.Type == TypeElementColumnSet:
-		v.SelfValidate(Columns(e.Columns))
+		v.SelfValidateNested(Columns(e.Columns))
+		v.SuccessfulFuncCall(func() error { return assertValidPixelSizeOrEmptyValue(e.MinHeight) })
 
 		if e.SelectAction != nil {
-			v.SelfValidate(e.SelectAction)
+			v.SelfValidateNested(e.SelectAction)
 		}
 
 	// Actions collection is required for ActionSet element type.
 	// https://adaptivecards.io/explorer/ActionSet.html
 	case e.Type == TypeElementActionSet:
 		v.NotEmptyCollection("Actions", e.Type, ErrMissingValue, e.Actions)
-		v.SelfValidate(Actions(e.Actions))
+		v.SelfValidateNested(Actions(e.Actions))
 
 	// Items collection is required for Container element type.
 	// https://adaptivecards.io/explorer/Container.html
 	case e.Type == TypeElementContainer:
 		v.NotEmptyCollection("Items", e.Type, ErrMissingValue, e.Items)
-		v.SelfValidate(Elements(e.Items))
+		v.SelfValidateNested(Elements(e.Items))
+		v.SuccessfulFuncCall(func() error { return assertValidPixelSizeOrEmptyValue(e.MinHeight) })
+		v.InListIfFieldValNotEmpty(
+			e.VerticalContentAlignment,
+			"VerticalContentAlignment",
+			e.Type,
+			supportedVerticalContentAlignmentValues(),
+			ErrInvalidFieldValue,
+		)
+		v.SuccessfulFuncCall(
+			func() error {
+				return assertHeightAlignmentFieldsSetWhenRequired(
+					e.MinHeight, e.VerticalContentAlignment,
+				)
+			},
+		)
+
+		if e.BackgroundImage != nil {
+			v.SelfValidate(e.BackgroundImage)
+		}
 
 		if e.SelectAction != nil {
-			v.SelfValidate(e.SelectAction)
+			v.SelfValidateNested(e.SelectAction)
 		}
 
+	// Inlines collection is required for RichTextBlock element type.
+	// https://adaptivecards.io/explorer/RichTextBlock.html
+	case e.Type == TypeElementRichTextBlock:
+		v.SuccessfulFuncCall(func() error { return assertRichTextBlockValues(e) })
+		v.SelfValidateNested(TextRuns(e.Inlines))
+
 	// URL is required for Image element type.
 	// https://adaptivecards.io/explorer/Image.html
 	case e.Type == TypeElementImage:
 		v.NotEmptyValue(e.URL, "URL", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertImageValues(e) })
+
+		if e.SelectAction != nil {
+			v.SelfValidateNested(e.SelectAction)
+		}
+
+	// Images collection is required for ImageSet element type.
+	// https://adaptivecards.io/explorer/ImageSet.html
+	case e.Type == TypeElementImageSet:
+		v.InListIfFieldValNotEmpty(e.ImageSize, "ImageSize", e.Type, supportedImageSetSizeValues(), ErrInvalidFieldValue)
+		v.SuccessfulFuncCall(func() error { return assertImageSetValues(e, v.SkipNested) })
 
 	// Facts collection is required for FactSet element type.
 	// https://adaptivecards.io/explorer/FactSet.html
@@ -1387,18 +2369,55 @@ func (e Element) Validate() error {
 			ErrInvalidFieldValue,
 		)
 
-		v.SelfValidate(TableRows(e.Rows))
+		for _, row := range e.Rows {
+			row := row
+			v.SuccessfulFuncCall(func() error { return row.validate(v.SkipNested) })
+		}
 
 		v.SelfValidate(TableColumnDefinitions(e.Columns))
 
 	case e.Type == TypeElementMSTeamsCodeBlock:
 		v.NotEmptyValue(e.CodeSnippet, "CodeSnippet", e.Type, ErrMissingValue)
 		v.NotEmptyValue(e.Language, "Language", e.Type, ErrMissingValue)
-	}
 
-	// Return the last recorded validation error, or nil if no validation
-	// errors occurred.
-	return v.Err()
+	// ID is required for all Input element types in order to identify the
+	// gathered value.
+	// https://adaptivecards.io/explorer/Input.Text.html
+	case e.Type == TypeElementInputText:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertInputTextValues(e) })
+
+		if e.InlineAction != nil {
+			v.SelfValidateNested(e.InlineAction)
+		}
+
+	// https://adaptivecards.io/explorer/Input.Number.html
+	case e.Type == TypeElementInputNumber:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertInputNumberValues(e) })
+
+	// https://adaptivecards.io/explorer/Input.Date.html
+	case e.Type == TypeElementInputDate:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertInputTemporalValues(e, DateInputFormat) })
+
+	// https://adaptivecards.io/explorer/Input.Time.html
+	case e.Type == TypeElementInputTime:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertInputTemporalValues(e, TimeInputFormat) })
+
+	// https://adaptivecards.io/explorer/Input.ChoiceSet.html
+	case e.Type == TypeElementInputChoiceSet:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.SelfValidate(Choices(e.Choices))
+		v.SuccessfulFuncCall(func() error { return assertInputChoiceSetValues(e) })
+
+	// https://adaptivecards.io/explorer/Input.Toggle.html
+	case e.Type == TypeElementInputToggle:
+		v.NotEmptyValue(e.ID, "ID", e.Type, ErrMissingValue)
+		v.NotEmptyValue(e.Title, "Title", e.Type, ErrMissingValue)
+		v.SuccessfulFuncCall(func() error { return assertInputToggleValues(e) })
+	}
 }
 
 // Validate asserts that the collection of Column values are all valid.
@@ -1540,7 +2559,13 @@ func (tr *TableRow) AddCell(cells ...TableCell) error {
 
 // Validate asserts that fields have valid values.
 func (tr TableRow) Validate() error {
-	v := validator.Validator{}
+	return tr.validate(false)
+}
+
+// validate asserts that fields have valid values. If skipNested is true, the
+// elements of the cells are not validated.
+func (tr TableRow) validate(skipNested bool) error {
+	v := validator.Validator{SkipNested: skipNested}
 
 	v.FieldHasSpecificValueIfFieldNotEmpty(
 		tr.Type,
@@ -1574,8 +2599,10 @@ func (tr TableRow) Validate() error {
 		ErrInvalidFieldValue,
 	)
 
-	// Validate collection by using "wrapper" type.
-	v.SelfValidate(TableCells(tr.Cells))
+	for _, cell := range tr.Cells {
+		cell := cell
+		v.SuccessfulFuncCall(func() error { return cell.validate(skipNested) })
+	}
 
 	return v.Err()
 }
@@ -1615,7 +2642,13 @@ func (tr *TableCell) AddElement(elements ...*Element) error {
 
 // Validate asserts that fields have valid values.
 func (tr TableCell) Validate() error {
-	v := validator.Validator{}
+	return tr.validate(false)
+}
+
+// validate asserts that fields have valid values. If skipNested is true, the
+// items of the cell are not validated.
+func (tr TableCell) validate(skipNested bool) error {
+	v := validator.Validator{SkipNested: skipNested}
 
 	v.FieldHasSpecificValueIfFieldNotEmpty(
 		tr.Type,
@@ -1662,7 +2695,7 @@ func (tr TableCell) Validate() error {
 	)
 
 	for _, item := range tr.Items {
-		v.SelfValidate(item)
+		v.SelfValidateNested(item)
 	}
 
 	return v.Err()
@@ -1684,6 +2717,7 @@ func (c *Column) AddSelectAction(action interface{}) error {
 			Title:    v.Title,
 			URL:      v.URL,
 			Fallback: v.Fallback,
+			Requires: v.Requires,
 		}
 
 		// Don't touch the new TargetElements field unless the provided Action
@@ -1716,6 +2750,14 @@ func (c *Column) AddSelectAction(action interface{}) error {
 func (c Column) Validate() error {
 	v := validator.Validator{}
 
+	c.validate(&v)
+
+	return v.Err()
+}
+
+// validate applies the validation checks of Column.Validate using the given
+// Validator.
+func (c Column) validate(v *validator.Validator) {
 	v.FieldHasSpecificValue(
 		c.Type,
 		"type",
@@ -1728,19 +2770,59 @@ func (c Column) Validate() error {
 		func() error { return assertColumnWidthValidValues(c) },
 	)
 
+	v.InListIfFieldValNotEmpty(
+		c.Style,
+		"Style",
+		c.Type,
+		supportedContainerStyleValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.InListIfFieldValNotEmpty(
+		c.Spacing,
+		"Spacing",
+		c.Type,
+		supportedSpacingValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.SuccessfulFuncCall(
+		func() error { return assertValidPixelSizeOrEmptyValue(c.MinHeight) },
+	)
+
+	v.InListIfFieldValNotEmpty(
+		c.VerticalContentAlignment,
+		"VerticalContentAlignment",
+		c.Type,
+		supportedVerticalContentAlignmentValues(),
+		ErrInvalidFieldValue,
+	)
+
+	// Both are optional fields, unless MinHeight is set in which case
+	// VerticalContentAlignment is required.
+	v.SuccessfulFuncCall(
+		func() error {
+			return assertHeightAlignmentFieldsSetWhenRequired(
+				c.MinHeight, c.VerticalContentAlignment,
+			)
+		},
+	)
+
+	if c.BackgroundImage != nil {
+		v.SelfValidate(c.BackgroundImage)
+	}
+
 	// Assert that the collection does not contain nil items.
 	v.NoNilValuesInCollection("Items", c.Type, ErrMissingValue, c.Items)
 
 	// Convert []*Element to ColumnItems so that we can use its Validate()
 	// method to handle cases where nil values could be present in the
 	// collection.
-	v.SelfValidate(ColumnItems(c.Items))
+	v.SelfValidateNested(ColumnItems(c.Items))
 
 	if c.SelectAction != nil {
-		v.SelfValidate(c.SelectAction)
+		v.SelfValidateNested(c.SelectAction)
 	}
-
-	return v.Err()
 }
 
 // Validate asserts that the collection of Fact values are all valid.
@@ -1784,11 +2866,19 @@ func (m MSTeams) Validate() error {
 
 // Validate asserts that fields have valid values.
 func (i ISelectAction) Validate() error {
+	v := validator.Validator{}
+
+	i.validate(&v)
+
+	return v.Err()
+}
+
+// validate applies the validation checks of ISelectAction.Validate using the
+// given Validator.
+func (i ISelectAction) validate(v *validator.Validator) {
 	supportedISelectActionValues := supportedISelectActionValues(AdaptiveCardMaxVersion)
 	fallbackValues := supportedActionFallbackValues(AdaptiveCardMaxVersion)
 
-	v := validator.Validator{}
-
 	// Some supportedISelectActionValues are restricted to later Adaptive Card
 	// schema versions.
 	v.InList(
@@ -1807,6 +2897,27 @@ func (i ISelectAction) Validate() error {
 		ErrInvalidFieldValue,
 	)
 
+	v.SuccessfulFuncCall(func() error { return assertFallbackActionFields(i.Type, i.Fallback, i.FallbackAction != nil) })
+	v.SuccessfulFuncCall(func() error { return assertValidRequires(i.Requires) })
+
+	if i.FallbackAction != nil {
+		v.SelfValidateNested(i.FallbackAction)
+	}
+
+	v.InListIfFieldValNotEmpty(
+		i.AssociatedInputs,
+		"AssociatedInputs",
+		"ISelectAction",
+		supportedAssociatedInputsValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.SuccessfulFuncCall(
+		func() error {
+			return assertActionPayloadFields(i.Type, i.Data, i.Verb, i.AssociatedInputs)
+		},
+	)
+
 	// See also: Action.Validate() logic.
 	switch {
 	case i.Type == TypeActionOpenURL:
@@ -1818,8 +2929,6 @@ func (i ISelectAction) Validate() error {
 	case i.Type == TypeActionToggleVisibility:
 		v.NotEmptyCollection("TargetElements", i.Type, ErrMissingValue, i.TargetElements)
 	}
-
-	return v.Err()
 }
 
 // Validate asserts that the collection of Action values are all valid.
@@ -1933,32 +3042,65 @@ func (a *Action) AddTargetElementID(visible *bool, elementIDs ...string) error {
 
 // Validate asserts that fields have valid values.
 func (a Action) Validate() error {
+	v := validator.Validator{}
+
+	a.validate(&v)
+
+	// Return the last recorded validation error, or nil if no validation
+	// errors occurred.
+	return v.Err()
+}
+
+// validate applies the validation checks of Action.Validate using the given
+// Validator.
+func (a Action) validate(v *validator.Validator) {
 	actionValues := supportedActionValues(AdaptiveCardMaxVersion)
 	fallbackValues := supportedActionFallbackValues(AdaptiveCardMaxVersion)
 
-	v := validator.Validator{}
-
 	// Some Actions are restricted to later Adaptive Card schema versions.
 	v.InList(a.Type, "Type", "action", actionValues, ErrInvalidType)
+	v.InListIfFieldValNotEmpty(a.Fallback, "Fallback", "action", fallbackValues, ErrInvalidFieldValue)
+	v.SuccessfulFuncCall(func() error { return assertFallbackActionFields(a.Type, a.Fallback, a.FallbackAction != nil) })
+	v.SuccessfulFuncCall(func() error { return assertValidRequires(a.Requires) })
+	v.InListIfFieldValNotEmpty(a.Style, "Style", "action", supportedActionStyleValues(), ErrInvalidFieldValue)
+	v.InListIfFieldValNotEmpty(
+		a.AssociatedInputs,
+		"AssociatedInputs",
+		"action",
+		supportedAssociatedInputsValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.SuccessfulFuncCall(
+		func() error {
+			return assertActionPayloadFields(a.Type, a.Data, a.Verb, a.AssociatedInputs)
+		},
+	)
+
+	if a.FallbackAction != nil {
+		v.SelfValidateNested(a.FallbackAction)
+	}
 
 	switch {
 	case a.Type == TypeActionOpenURL:
 		v.NotEmptyValue(a.URL, "URL", a.Type, ErrMissingValue)
 
-	case a.Fallback != "":
-		v.InList(a.Fallback, "Fallback", "action", fallbackValues, ErrInvalidFieldValue)
-
 	case a.Type == TypeActionToggleVisibility:
 		v.NotEmptyCollection("TargetElements", a.Type, ErrMissingValue, a.TargetElements)
 
+	// Card is required for the Action.ShowCard type.
+	// https://adaptivecards.io/explorer/Action.ShowCard.html
+	case a.Type == TypeActionShowCard:
+		v.SuccessfulFuncCall(func() error { return assertShowCardNestingDepth(a) })
+
+		if a.Card != nil {
+			v.SelfValidateNested(a.Card)
+		}
+
 	// Optional, but only supported by the Action.ShowCard type.
 	case a.Card != nil:
 		v.FieldHasSpecificValue(a.Type, "type", TypeActionShowCard, "type", ErrInvalidType)
 	}
-
-	// Return the last recorded validation error, or nil if no validation
-	// errors occurred.
-	return v.Err()
 }
 
 // Validate asserts that the collection of Mention values are all valid.
@@ -1993,7 +3135,13 @@ func (m Mention) Validate() error {
 		)
 	}
 
-	return nil
+	// User mentions are not validated further for compatibility with
+	// earlier releases. See also LintRuleMentionTextFormat.
+	if m.Mentioned.Type == "" && m.Mentioned.ConversationIdentityType == "" {
+		return nil
+	}
+
+	return m.Mentioned.Validate()
 }
 
 // Validate asserts that fields have valid values.
@@ -2012,7 +3160,19 @@ func (m Mentioned) Validate() error {
 		)
 	}
 
-	return nil
+	v := validator.Validator{}
+
+	v.InListIfFieldValNotEmpty(m.Type, "Type", "Mentioned", supportedMentionedTypeValues(), ErrInvalidType)
+	v.InListIfFieldValNotEmpty(
+		m.ConversationIdentityType,
+		"ConversationIdentityType",
+		"Mentioned",
+		supportedConversationIdentityTypeValues(),
+		ErrInvalidType,
+	)
+	v.SuccessfulFuncCall(func() error { return assertValidConversationID(m) })
+
+	return v.Err()
 }
 
 // Mention uses the provided display name, ID and text values to add a new
@@ -2237,9 +3397,15 @@ func (c *Card) AddAction(prepend bool, actions ...Action) error {
 	return nil
 }
 
-// GetElement searches all Element values attached to the Card for the
-// specified ID (case sensitive). If found, a pointer to the Element is
-// returned, otherwise an error is returned.
+// GetElement searches all Element values attached to the Card, including
+// nested elements (see Card.Walk), for the specified ID (case sensitive). If
+// found, a pointer to the Element within the Card is returned, otherwise an
+// error is returned. The fallback content of elements and actions is not
+// searched.
+//
+// NOTE: Earlier releases returned a copy of the top-level Body element
+// containing a matching Container item. The matching element itself is now
+// returned, and changes made using the pointer are applied to the Card.
 func (c *Card) GetElement(id string) (*Element, error) {
 	if id == "" {
 		return nil, fmt.Errorf(
@@ -2248,24 +3414,16 @@ func (c *Card) GetElement(id string) (*Element, error) {
 		)
 	}
 
-	for _, element := range c.Body {
-		if element.ID == id {
-			return &element, nil
-		}
-
-		// If the Element is a Container, we need to evaluate its collection
-		// of Elements.
-		for _, item := range element.Items {
-			if item.ID == id {
-				return &element, nil
-			}
-		}
+	element := c.findElement(func(e *Element) bool { return e.ID == id })
+	if element == nil {
+		return nil, fmt.Errorf(
+			"unable to retrieve element id %q: %w",
+			id,
+			ErrValueNotFound,
+		)
 	}
 
-	return nil, fmt.Errorf(
-		"unable to retrieve element id: %w",
-		ErrValueNotFound,
-	)
+	return element, nil
 }
 
 // AddFactSet adds one or more provided FactSet elements to the Body of the
@@ -3117,6 +4275,7 @@ func (c *Container) AddSelectAction(action interface{}) error {
 			Title:    v.Title,
 			URL:      v.URL,
 			Fallback: v.Fallback,
+			Requires: v.Requires,
 		}
 
 		// Don't touch the new TargetElements field unless the provided Action
@@ -3218,7 +4377,7 @@ func cardBodyHasMention(body []Element, mentions []Mention) bool {
 
 	elementsHaveMention := func(elements []Element, m Mention) bool {
 		for _, element := range elements {
-			if element.HasMentionText(m) {
+			if elementTreeHasMentionText(element, m) {
 				return true
 			}
 		}
@@ -3252,7 +4411,8 @@ func assertHeightAlignmentFieldsSetWhenRequired(minHeight string, verticalConten
 
 // assertCardBodyHasMention asserts that if there are recorded user mentions,
 // then Mention.Text is contained (substring match) within an applicable field
-// of a supported Element of the Card Body.
+// of a supported Element of the Card Body, including elements nested within
+// Container, ColumnSet and Table elements.
 //
 // At present, this includes the Text field of a TextBlock Element or
 // the Title or Value fields of a Fact from a FactSet.
@@ -3300,7 +4460,7 @@ func assertColumnWidthValidValues(c Column) error {
 		}
 
 	// Number representing relative width of the column.
-	case int:
+	case int, float64:
 
 	// Unsupported value.
 	default:
@@ -3334,7 +4494,7 @@ func assertTableColumnDefinitionWidthValidValues(tcd TableColumnDefinition) erro
 		}
 
 	// Number representing relative width of the column.
-	case int:
+	case int, float64:
 
 	// Unsupported value.
 	default:
@@ -0,0 +1,286 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CardBuilder composes a Card using chainable methods and returns the Card
+// attached to a validated Message from Build, e.g.:
+//
+//	msg, err := adaptivecard.NewCardBuilder().
+//		Heading("Disk usage alert").
+//		Text("Usage exceeds the configured threshold.").
+//		Fact("Host", "host01").
+//		Fact("Usage", "91%").
+//		OpenURL("Runbook", "https://example.com/runbook").
+//		Build()
+//
+// Errors encountered while adding content do not interrupt the chain; they
+// are collected and returned by Build along with any validation errors.
+type CardBuilder struct {
+	card Card
+	errs ValidationErrors
+}
+
+// NewCardBuilder creates a new CardBuilder for an empty Card using the
+// default card version.
+func NewCardBuilder() *CardBuilder {
+	return &CardBuilder{card: NewCard()}
+}
+
+// NewColumnWithElements creates a new Column of the given width (e.g.,
+// "auto", "stretch" or a relative width such as 2) containing the given
+// elements for use with CardBuilder.Columns. An empty width leaves the width
+// unset.
+func NewColumnWithElements(width interface{}, elements ...Element) Column {
+	column := NewColumn()
+
+	if width != "" {
+		column.Width = width
+	}
+
+	for i := range elements {
+		column.Items = append(column.Items, &elements[i])
+	}
+
+	return column
+}
+
+// addError records the given error for the card location at the given JSON
+// Pointer path.
+func (b *CardBuilder) addError(path string, err error) {
+	b.errs = append(b.errs, newValidationError(path, err))
+}
+
+// bodyPath returns the path of the next element added to the card body.
+func (b *CardBuilder) bodyPath() string {
+	return "/body/" + strconv.Itoa(len(b.card.Body))
+}
+
+// actionPath returns the path of the next action added to the card.
+func (b *CardBuilder) actionPath() string {
+	return "/actions/" + strconv.Itoa(len(b.card.Actions))
+}
+
+// Version sets the card version (e.g., "1.5").
+func (b *CardBuilder) Version(version string) *CardBuilder {
+	b.card.Version = version
+
+	return b
+}
+
+// FullWidth enables full width display of the card.
+func (b *CardBuilder) FullWidth() *CardBuilder {
+	b.card.SetFullWidth()
+
+	return b
+}
+
+// Element adds the given elements to the card body.
+func (b *CardBuilder) Element(elements ...Element) *CardBuilder {
+	path := b.bodyPath()
+
+	if err := b.card.AddElement(false, elements...); err != nil {
+		b.addError(path, err)
+	}
+
+	return b
+}
+
+// Heading adds a TextBlock formatted as a heading (see NewTitleTextBlock).
+func (b *CardBuilder) Heading(text string) *CardBuilder {
+	return b.Element(NewTitleTextBlock(text, true))
+}
+
+// Text adds a TextBlock with text wrapping enabled.
+func (b *CardBuilder) Text(text string) *CardBuilder {
+	return b.Element(NewTextBlock(text, true))
+}
+
+// Fact adds a Fact with the given title and value. Consecutive facts are
+// added to the same FactSet.
+func (b *CardBuilder) Fact(title string, value string) *CardBuilder {
+	if n := len(b.card.Body); n > 0 && b.card.Body[n-1].Type == TypeElementFactSet {
+		factSet := FactSet(b.card.Body[n-1])
+
+		if err := factSet.AddFact(Fact{Title: title, Value: value}); err != nil {
+			b.addError("/body/"+strconv.Itoa(n-1)+"/facts/"+strconv.Itoa(len(factSet.Facts)), err)
+		}
+
+		b.card.Body[n-1] = Element(factSet)
+
+		return b
+	}
+
+	return b.Facts(Fact{Title: title, Value: value})
+}
+
+// Facts adds a FactSet containing the given facts.
+func (b *CardBuilder) Facts(facts ...Fact) *CardBuilder {
+	factSet := NewFactSet()
+
+	if err := factSet.AddFact(facts...); err != nil {
+		b.addError(b.bodyPath(), err)
+
+		return b
+	}
+
+	return b.Element(Element(factSet))
+}
+
+// Columns adds a ColumnSet containing the given columns (see
+// NewColumnWithElements).
+func (b *CardBuilder) Columns(columns ...Column) *CardBuilder {
+	if len(columns) == 0 {
+		b.addError(b.bodyPath(), fmt.Errorf("received empty collection of columns: %w", ErrMissingValue))
+
+		return b
+	}
+
+	columnSet := NewColumnSet()
+	columnSet.Columns = columns
+
+	return b.Element(columnSet)
+}
+
+// Table adds a Table with a TextBlock cell for each of the given values.
+// The number of columns is determined by the first row. If specified, the
+// first row is displayed as header row.
+func (b *CardBuilder) Table(rows [][]string, firstRowIsHeaders bool) *CardBuilder {
+	cells := make([][]TableCell, 0, len(rows))
+
+	for _, row := range rows {
+		items := make([]interface{}, len(row))
+		for i := range row {
+			items[i] = row[i]
+		}
+
+		rowCells, err := NewTableCellsWithTextBlock(items)
+		if err != nil {
+			b.addError(b.bodyPath(), err)
+
+			return b
+		}
+
+		cells = append(cells, rowCells)
+	}
+
+	table, err := NewTableFromTableCells(cells, 0, firstRowIsHeaders, true)
+	if err != nil {
+		b.addError(b.bodyPath(), err)
+
+		return b
+	}
+
+	return b.Element(table)
+}
+
+// CodeBlock adds a CodeBlock displaying the given snippet with syntax
+// highlighting for the given language (see NewCodeBlock).
+func (b *CardBuilder) CodeBlock(snippet string, language string) *CardBuilder {
+	return b.Element(NewCodeBlock(snippet, language, 1))
+}
+
+// Mention adds a user Mention for the given display name and ID along with
+// a TextBlock consisting of the mention text followed by the given text.
+func (b *CardBuilder) Mention(displayName string, id string, text string) *CardBuilder {
+	mention, err := NewMention(displayName, id)
+	if err != nil {
+		b.addError(b.bodyPath(), err)
+
+		return b
+	}
+
+	textBlock := NewTextBlock(mention.Text, true)
+	if text != "" {
+		textBlock.Text += defaultMentionTextSeparator + text
+	}
+
+	b.card.MSTeams.Entities = append(b.card.MSTeams.Entities, mention)
+
+	return b.Element(textBlock)
+}
+
+// Action adds the given actions to the card.
+func (b *CardBuilder) Action(actions ...Action) *CardBuilder {
+	path := b.actionPath()
+
+	if err := b.card.AddAction(false, actions...); err != nil {
+		b.addError(path, err)
+	}
+
+	return b
+}
+
+// OpenURL adds an Action.OpenUrl action with the given title and URL.
+func (b *CardBuilder) OpenURL(title string, url string) *CardBuilder {
+	action, err := NewActionOpenURL(url, title)
+	if err != nil {
+		b.addError(b.actionPath(), err)
+
+		return b
+	}
+
+	return b.Action(action)
+}
+
+// ShowCard adds an Action.ShowCard action with the given title displaying
+// the given card.
+func (b *CardBuilder) ShowCard(title string, card Card) *CardBuilder {
+	action, err := NewActionShowCard(title, card)
+	if err != nil {
+		b.addError(b.actionPath(), err)
+
+		return b
+	}
+
+	return b.Action(action)
+}
+
+// ToggleVisibility adds an Action.ToggleVisibility action with the given
+// title toggling the visibility of the elements with the given IDs.
+func (b *CardBuilder) ToggleVisibility(title string, elementIDs ...string) *CardBuilder {
+	action := NewActionToggleVisibility(title)
+
+	if err := action.AddTargetElementID(nil, elementIDs...); err != nil {
+		b.addError(b.actionPath(), err)
+
+		return b
+	}
+
+	return b.Action(action)
+}
+
+// Card returns the Card composed so far without validating it.
+func (b *CardBuilder) Card() Card {
+	return b.card
+}
+
+// Build returns a new Message containing the composed Card. If errors were
+// encountered while adding content, they are returned as ValidationErrors
+// and the Message is not created. Otherwise the Message is validated (see
+// Message.Validate) and returned only if validation succeeds.
+func (b *CardBuilder) Build() (*Message, error) {
+	if len(b.errs) > 0 {
+		return nil, b.errs
+	}
+
+	msg, err := NewMessageFromCard(b.card)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCardBuilderBuild(t *testing.T) {
+	details := NewCard()
+	details.Body = []Element{NewTextBlock("details", true)}
+
+	hidden := NewHiddenTextBlock("hidden", true)
+	hidden.ID = "hidden"
+
+	msg, err := NewCardBuilder().
+		Version("1.5").
+		FullWidth().
+		Heading("Disk usage alert").
+		Mention("Jane Doe", "jane.doe@example.com", "please review").
+		Fact("Host", "host01").
+		Fact("Usage", "91%").
+		Columns(
+			NewColumnWithElements("auto", NewTextBlock("left", true)),
+			NewColumnWithElements(2, NewTextBlock("right", true)),
+		).
+		Table([][]string{{"Mount", "Usage"}, {"/var", "91%"}}, true).
+		CodeBlock("df -h", "Bash").
+		Element(hidden).
+		OpenURL("Runbook", "https://example.com/runbook").
+		ShowCard("Details", details).
+		ToggleVisibility("Toggle", "hidden").
+		Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	card := msg.Attachments[0].Content
+
+	var types []string
+	for _, element := range card.Body {
+		types = append(types, element.Type)
+	}
+
+	wantTypes := []string{
+		TypeElementTextBlock,
+		TypeElementTextBlock,
+		TypeElementFactSet,
+		TypeElementColumnSet,
+		TypeElementTable,
+		TypeElementMSTeamsCodeBlock,
+		TypeElementTextBlock,
+	}
+
+	if !reflect.DeepEqual(types, wantTypes) {
+		t.Errorf("got body types %q; want %q", types, wantTypes)
+	}
+
+	if got := len(card.Body[2].Facts); got != 2 {
+		t.Errorf("got %d facts; want consecutive facts in a single FactSet", got)
+	}
+
+	if card.Body[1].Text != "<at>Jane Doe</at> please review" || len(card.MSTeams.Entities) != 1 {
+		t.Errorf("got mention text %q and entities %+v", card.Body[1].Text, card.MSTeams.Entities)
+	}
+
+	if len(card.Actions) != 3 || card.MSTeams.Width != MSTeamsWidthFull || card.Version != "1.5" {
+		t.Errorf("got actions %+v, width %q, version %q", card.Actions, card.MSTeams.Width, card.Version)
+	}
+}
+
+func TestCardBuilderErrors(t *testing.T) {
+	_, err := NewCardBuilder().
+		Text("summary").
+		Mention("", "jane.doe@example.com", "please review").
+		Columns().
+		Fact("Host", "host01").
+		Fact("", "").
+		OpenURL("Runbook", "").
+		Table(nil, false).
+		Build()
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	var paths []string
+	for _, validationErr := range validationErrs {
+		paths = append(paths, validationErr.Path)
+	}
+
+	wantPaths := []string{"/body/1", "/body/1", "/body/1/facts/1", "/actions/0", "/body/2"}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("got paths %q; want %q (error: %v)", paths, wantPaths, err)
+	}
+
+	if !errors.Is(err, ErrMissingValue) {
+		t.Errorf("got error %v; want %v", err, ErrMissingValue)
+	}
+
+	msg, err := NewCardBuilder().ToggleVisibility("Toggle", "missing").Build()
+	if msg != nil || !errors.Is(err, ErrTargetNotFound) {
+		t.Errorf("got %v, %v; want validation error %v", msg, err, ErrTargetNotFound)
+	}
+}
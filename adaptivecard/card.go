@@ -0,0 +1,134 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/validator"
+)
+
+// NewRefresh creates a new Refresh using the given Action.Execute action and
+// (optional) user IDs for which the card should automatically refresh. An
+// error is returned if invalid values are supplied.
+func NewRefresh(action Action, userIDs ...string) (Refresh, error) {
+	refresh := Refresh{
+		Action:  &action,
+		UserIDs: userIDs,
+	}
+
+	if err := refresh.Validate(); err != nil {
+		return Refresh{}, err
+	}
+
+	return refresh, nil
+}
+
+// Validate asserts that fields have valid values.
+func (r Refresh) Validate() error {
+	v := validator.Validator{}
+
+	r.validate(&v)
+
+	return v.Err()
+}
+
+// validate applies the validation checks of Refresh.Validate using the given
+// Validator.
+func (r Refresh) validate(v *validator.Validator) {
+	if r.Action == nil {
+		v.SuccessfulFuncCall(func() error {
+			return fmt.Errorf(
+				"required field Action is empty for Refresh: %w",
+				ErrMissingValue,
+			)
+		})
+
+		return
+	}
+
+	v.FieldHasSpecificValue(
+		r.Action.Type,
+		"action type",
+		TypeActionExecute,
+		"Refresh",
+		ErrInvalidType,
+	)
+
+	v.SelfValidateNested(r.Action)
+
+	v.SuccessfulFuncCall(func() error {
+		if len(r.UserIDs) > RefreshMaxUserIDs {
+			return fmt.Errorf(
+				"%d UserIDs specified for Refresh; Microsoft Teams supports up to %d: %w",
+				len(r.UserIDs),
+				RefreshMaxUserIDs,
+				ErrInvalidFieldValue,
+			)
+		}
+
+		return nil
+	})
+}
+
+// Validate asserts that fields have valid values.
+func (a Authentication) Validate() error {
+	v := validator.Validator{}
+
+	if a.TokenExchangeResource != nil {
+		v.SelfValidate(a.TokenExchangeResource)
+	}
+
+	for _, button := range a.Buttons {
+		v.SelfValidate(button)
+	}
+
+	return v.Err()
+}
+
+// Validate asserts that fields have valid values.
+func (ter TokenExchangeResource) Validate() error {
+	v := validator.Validator{}
+
+	v.NotEmptyValue(ter.ID, "ID", "TokenExchangeResource", ErrMissingValue)
+	v.NotEmptyValue(ter.URI, "URI", "TokenExchangeResource", ErrMissingValue)
+	v.NotEmptyValue(ter.ProviderID, "ProviderID", "TokenExchangeResource", ErrMissingValue)
+
+	return v.Err()
+}
+
+// Validate asserts that fields have valid values.
+func (acb AuthCardButton) Validate() error {
+	v := validator.Validator{}
+
+	v.NotEmptyValue(acb.Type, "Type", "AuthCardButton", ErrMissingValue)
+	v.NotEmptyValue(acb.Value, "Value", "AuthCardButton", ErrMissingValue)
+
+	return v.Err()
+}
+
+// assertValidLangOrEmptyValue asserts that the given value matches LangRegex.
+// An empty value is permitted.
+func assertValidLangOrEmptyValue(val string) error {
+	if val == "" {
+		return nil
+	}
+
+	matched, _ := regexp.MatchString(LangRegex, val)
+	if !matched {
+		return fmt.Errorf(
+			"invalid Lang %q; expected ISO-639-1 language code (e.g., %q): %w",
+			val,
+			"en",
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
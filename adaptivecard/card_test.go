@@ -0,0 +1,91 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCardLevelProperties(t *testing.T) {
+	rtl := true
+
+	refresh, err := NewRefresh(NewActionExecute("Refresh", "refresh", nil), "user-1")
+	if err != nil {
+		t.Fatalf("unexpected error creating refresh: %v", err)
+	}
+
+	topLevelCard := func(version string, fn func(c *Card)) TopLevelCard {
+		c := NewCard()
+		c.Version = version
+		c.Body = []Element{NewTextBlock("body", false)}
+		fn(&c)
+		return TopLevelCard{Card: c}
+	}
+
+	tests := []struct {
+		name    string
+		card    TopLevelCard
+		wantErr error
+	}{
+		{name: "localized", card: topLevelCard("1.0", func(c *Card) {
+			c.Lang = "de"
+			c.Speak = "Alarm für host01"
+			c.BackgroundImage = &BackgroundImage{URL: "https://example.com/bg.png"}
+		})},
+		{name: "invalid lang", card: topLevelCard("1.0", func(c *Card) { c.Lang = "german" }), wantErr: ErrInvalidFieldValue},
+		{name: "select action", card: topLevelCard("1.1", func(c *Card) {
+			c.SelectAction = &ISelectAction{Type: TypeActionOpenURL, URL: "https://example.com"}
+		})},
+		{name: "select action version", card: topLevelCard("1.0", func(c *Card) {
+			c.SelectAction = &ISelectAction{Type: TypeActionOpenURL, URL: "https://example.com"}
+		}), wantErr: ErrUnsupportedFeature},
+		{name: "background image fill mode version", card: topLevelCard("1.1", func(c *Card) {
+			bg := NewBackgroundImage("https://example.com/bg.png", BackgroundImageFillModeRepeat)
+			c.BackgroundImage = &bg
+		}), wantErr: ErrUnsupportedFeature},
+		{name: "refresh", card: topLevelCard("1.4", func(c *Card) { c.Refresh = &refresh })},
+		{name: "refresh version", card: topLevelCard("1.3", func(c *Card) { c.Refresh = &refresh }), wantErr: ErrUnsupportedFeature},
+		{name: "refresh not execute", card: topLevelCard("1.4", func(c *Card) {
+			c.Refresh = &Refresh{Action: &Action{Type: TypeActionOpenURL, URL: "https://example.com"}}
+		}), wantErr: ErrInvalidType},
+		{name: "authentication", card: topLevelCard("1.4", func(c *Card) {
+			c.Authentication = &Authentication{
+				ConnectionName: "oauth",
+				Buttons:        []AuthCardButton{{Type: "signin", Value: "https://example.com/login"}},
+			}
+		})},
+		{name: "authentication incomplete token exchange", card: topLevelCard("1.4", func(c *Card) {
+			c.Authentication = &Authentication{TokenExchangeResource: &TokenExchangeResource{ID: "1"}}
+		}), wantErr: ErrMissingValue},
+		{name: "rtl version", card: topLevelCard("1.4", func(c *Card) { c.Rtl = &rtl }), wantErr: ErrUnsupportedFeature},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.card.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	tooMany := make([]string, RefreshMaxUserIDs+1)
+	for i := range tooMany {
+		tooMany[i] = "user"
+	}
+
+	if _, err := NewRefresh(NewActionExecute("Refresh", "refresh", nil), tooMany...); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
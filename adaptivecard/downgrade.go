@@ -0,0 +1,685 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DowngradeChange describes a modification applied to a Card by DowngradeCard
+// in order to target an earlier schema version.
+type DowngradeChange struct {
+	// Path is the JSON Pointer (RFC 6901) location of the modified content
+	// in the original Card (e.g., "/body/0/items/1").
+	Path string
+
+	// Feature is the name of the unsupported feature (see Feature).
+	Feature string
+
+	// Description describes the modification.
+	Description string
+}
+
+// String provides a human readable description of the change.
+func (dc DowngradeChange) String() string {
+	return fmt.Sprintf("%s: %s (%s)", dc.Path, dc.Description, dc.Feature)
+}
+
+// DowngradeCard returns a copy of the given Card rewritten to target the
+// specified schema version along with a list of the changes applied. The
+// given Card is not modified.
+//
+// Features introduced in a later schema version than the target version are
+// rewritten where possible and removed otherwise:
+//
+//   - unsupported elements and actions, as well as those whose Requires
+//     can't be met by the target version, are replaced with their fallback
+//     element or action or dropped if specified by their Fallback
+//   - Table elements are converted to a Container of ColumnSet elements (one
+//     per row)
+//   - RichTextBlock elements are converted to TextBlock elements; bold and
+//     italic formatting is preserved using markdown and the select actions
+//     of inlines are moved to the card actions
+//   - the actions of ActionSet elements are moved to the card actions
+//   - the label of Input elements is moved to a preceding TextBlock
+//   - unsupported actions are dropped if their Fallback is "drop", replaced
+//     with an Action.OpenUrl if a URL is available and dropped otherwise
+//   - unsupported properties and other unsupported elements are removed
+//
+// Schema versions are used as-is; this is intended for hosts (e.g., older
+// mobile clients) which do not render features introduced in later schema
+// versions. The Version of the returned Card is set to the target version
+// unless a lower version is already declared.
+func DowngradeCard(c Card, version float64) (Card, []DowngradeChange, error) {
+	if version < AdaptiveCardMinVersion {
+		return Card{}, nil, fmt.Errorf(
+			"unsupported target version %0.1f; expected minimum value of %0.1f: %w",
+			version,
+			AdaptiveCardMinVersion,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	d := downgrader{
+		version:  version,
+		versions: supportedFeatureVersions(),
+	}
+
+	result := d.card(c, "")
+
+	declared, err := strconv.ParseFloat(c.Version, 64)
+	if err != nil || declared > version {
+		result.Version = fmt.Sprintf(AdaptiveCardVersionTmpl, version)
+	}
+
+	return result, d.changes, nil
+}
+
+// downgrader rewrites Card content to target an earlier schema version.
+type downgrader struct {
+	version  float64
+	versions map[string]float64
+	changes  []DowngradeChange
+}
+
+// unsupported indicates whether the named feature was introduced in a later
+// schema version than the target version.
+func (d *downgrader) unsupported(feature string) bool {
+	version, ok := d.versions[feature]
+
+	return ok && version > d.version
+}
+
+func (d *downgrader) record(path string, feature string, description string) {
+	d.changes = append(d.changes, DowngradeChange{
+		Path:        path,
+		Feature:     feature,
+		Description: description,
+	})
+}
+
+// strip applies the given clear function and records the change if the
+// property is set and the named feature is unsupported. A true value is
+// returned if the property was removed.
+func (d *downgrader) strip(feature string, path string, isSet bool, clear func()) bool {
+	if !isSet || !d.unsupported(feature) {
+		return false
+	}
+
+	clear()
+	d.record(path, feature, "removed unsupported property")
+
+	return true
+}
+
+func (d *downgrader) card(c Card, path string) Card {
+	result := c
+
+	d.strip("AdaptiveCard.minHeight", path+"/minHeight", c.MinHeight != "", func() { result.MinHeight = "" })
+	d.strip("AdaptiveCard.verticalContentAlignment", path+"/verticalContentAlignment", c.VerticalContentAlignment != "", func() { result.VerticalContentAlignment = "" })
+	d.strip("AdaptiveCard.rtl", path+"/rtl", c.Rtl != nil, func() { result.Rtl = nil })
+	d.strip("AdaptiveCard.authentication", path+"/authentication", c.Authentication != nil, func() { result.Authentication = nil })
+
+	if !d.strip("AdaptiveCard.refresh", path+"/refresh", c.Refresh != nil, func() { result.Refresh = nil }) &&
+		c.Refresh != nil && c.Refresh.Action != nil {
+		refresh := *c.Refresh
+		if action, ok := d.action(*c.Refresh.Action, path+"/refresh/action"); ok {
+			refresh.Action = &action
+			result.Refresh = &refresh
+		} else {
+			result.Refresh = nil
+		}
+	}
+
+	if !d.strip("AdaptiveCard.selectAction", path+"/selectAction", c.SelectAction != nil, func() { result.SelectAction = nil }) &&
+		c.SelectAction != nil {
+		result.SelectAction = d.selectAction(*c.SelectAction, path+"/selectAction")
+	}
+
+	result.BackgroundImage = d.backgroundImage(c.BackgroundImage, path+"/backgroundImage")
+
+	var lifted []Action
+	result.Body = d.elements(c.Body, path+"/body", &lifted)
+	result.Actions = d.actions(c.Actions, path+"/actions")
+
+	if len(lifted) > 0 {
+		result.Actions = append(result.Actions, lifted...)
+	}
+
+	return result
+}
+
+func (d *downgrader) backgroundImage(bi *BackgroundImage, path string) *BackgroundImage {
+	if bi == nil {
+		return nil
+	}
+
+	result := *bi
+
+	d.strip("BackgroundImage.fillMode", path+"/fillMode", bi.FillMode != "", func() { result.FillMode = "" })
+	d.strip("BackgroundImage.horizontalAlignment", path+"/horizontalAlignment", bi.HorizontalAlignment != "", func() { result.HorizontalAlignment = "" })
+	d.strip("BackgroundImage.verticalAlignment", path+"/verticalAlignment", bi.VerticalAlignment != "", func() { result.VerticalAlignment = "" })
+
+	return &result
+}
+
+// elements rewrites the given collection of elements. Actions of unsupported
+// ActionSet elements are added to lifted.
+func (d *downgrader) elements(elements []Element, path string, lifted *[]Action) []Element {
+	if elements == nil {
+		return nil
+	}
+
+	result := make([]Element, 0, len(elements))
+	for i, element := range elements {
+		result = append(result, d.element(element, fmt.Sprintf("%s/%d", path, i), lifted)...)
+	}
+
+	return result
+}
+
+// element rewrites the given element, returning zero (element removed), one
+// or more elements in its place.
+func (d *downgrader) element(e Element, path string, lifted *[]Action) []Element {
+	if e.Fallback != nil && (d.unsupported(e.Type) || !requiresSatisfied(e.Requires, d.version)) {
+		if e.Fallback.Element == nil {
+			d.record(path, e.Type, "dropped unsupported element as specified by fallback")
+
+			return nil
+		}
+
+		d.record(path, e.Type, "replaced unsupported element with fallback element")
+
+		return d.element(*e.Fallback.Element, path+"/fallback", lifted)
+	}
+
+	switch {
+	case e.Type == TypeElementTable && d.unsupported(TypeElementTable):
+		if len(e.Rows) == 0 {
+			d.record(path, e.Type, "removed unsupported element without rows")
+
+			return nil
+		}
+
+		e = d.tableToContainer(e, path, lifted)
+		d.record(path, TypeElementTable, "converted Table to Container of ColumnSet elements")
+
+	case e.Type == TypeElementRichTextBlock && d.unsupported(TypeElementRichTextBlock):
+		*lifted = append(*lifted, d.textRunActions(e.Inlines, path+"/inlines")...)
+		e = richTextBlockToTextBlock(e)
+		d.record(
+			path,
+			TypeElementRichTextBlock,
+			"converted RichTextBlock to TextBlock; formatting other than bold and italic is not preserved",
+		)
+
+	case e.Type == TypeElementTextRun && d.unsupported(TypeElementTextRun):
+		e = Element{
+			Type:   TypeElementTextBlock,
+			ID:     e.ID,
+			Text:   e.Text,
+			Color:  e.Color,
+			Size:   e.Size,
+			Weight: e.Weight,
+			Wrap:   true,
+		}
+		d.record(path, TypeElementTextRun, "converted TextRun to TextBlock")
+
+	case e.Type == TypeElementActionSet && d.unsupported(TypeElementActionSet):
+		*lifted = append(*lifted, d.actions(e.Actions, path+"/actions")...)
+		d.record(path, TypeElementActionSet, "moved actions of ActionSet to card actions")
+
+		return nil
+
+	case d.unsupported(e.Type):
+		d.record(path, e.Type, "removed unsupported element")
+
+		return nil
+	}
+
+	var result []Element
+
+	if d.strip("Input.label", path+"/label", e.Label != "", func() {}) {
+		result = append(result, NewTextBlock(e.Label, true))
+		e.Label = ""
+	}
+
+	d.strip("Element.isVisible", path+"/isVisible", e.Visible != nil, func() { e.Visible = nil })
+	d.strip("Element.fallback", path+"/fallback", e.Fallback != nil, func() { e.Fallback = nil })
+	d.strip("Element.requires", path+"/requires", len(e.Requires) > 0, func() { e.Requires = nil })
+	d.strip(e.Type+".style."+e.Style, path+"/style", e.Style != "", func() { e.Style = "" })
+	d.strip(e.Type+".style", path+"/style", e.Style != "", func() { e.Style = "" })
+	d.strip(e.Type+".fontType", path+"/fontType", e.FontType != "", func() { e.FontType = "" })
+	d.strip(e.Type+".backgroundColor", path+"/backgroundColor", e.BackgroundColor != "", func() { e.BackgroundColor = "" })
+	d.strip(e.Type+".width", path+"/width", e.Width != "", func() { e.Width = "" })
+	d.strip(e.Type+".height", path+"/height", e.Height != "", func() { e.Height = "" })
+	d.strip(e.Type+".bleed", path+"/bleed", e.Bleed, func() { e.Bleed = false })
+	d.strip(e.Type+".minHeight", path+"/minHeight", e.MinHeight != "", func() { e.MinHeight = "" })
+	d.strip(e.Type+".verticalContentAlignment", path+"/verticalContentAlignment", e.VerticalContentAlignment != "", func() { e.VerticalContentAlignment = "" })
+	d.strip(e.Type+".backgroundImage", path+"/backgroundImage", e.BackgroundImage != nil, func() { e.BackgroundImage = nil })
+	d.strip(e.Type+".rtl", path+"/rtl", e.Rtl != nil, func() { e.Rtl = nil })
+	d.strip(e.Type+".wrap", path+"/wrap", e.Wrap, func() { e.Wrap = false })
+	d.strip(e.Type+".regex", path+"/regex", e.Regex != "", func() { e.Regex = "" })
+	d.strip(e.Type+".inlineAction", path+"/inlineAction", e.InlineAction != nil, func() { e.InlineAction = nil })
+	d.strip("Input.isRequired", path+"/isRequired", e.IsRequired, func() { e.IsRequired = false })
+	d.strip("Input.errorMessage", path+"/errorMessage", e.ErrorMessage != "", func() { e.ErrorMessage = "" })
+
+	// The MinHeight field requires the VerticalContentAlignment field; drop
+	// both if only the latter is unsupported.
+	if e.MinHeight != "" && e.VerticalContentAlignment == "" && e.Type == TypeElementContainer {
+		e.MinHeight = ""
+		d.record(path+"/minHeight", e.Type+".minHeight", "removed property requiring unsupported verticalContentAlignment")
+	}
+
+	if !d.strip(e.Type+".selectAction", path+"/selectAction", e.SelectAction != nil, func() { e.SelectAction = nil }) &&
+		e.SelectAction != nil {
+		e.SelectAction = d.selectAction(*e.SelectAction, path+"/selectAction")
+	}
+
+	if e.InlineAction != nil {
+		e.InlineAction = d.selectAction(*e.InlineAction, path+"/inlineAction")
+	}
+
+	e.BackgroundImage = d.backgroundImage(e.BackgroundImage, path+"/backgroundImage")
+	e.Items = d.elements(e.Items, path+"/items", lifted)
+	e.Images = d.elements(e.Images, path+"/images", lifted)
+	e.Actions = d.actions(e.Actions, path+"/actions")
+
+	if e.Fallback != nil && e.Fallback.Element != nil {
+		fallback := d.element(*e.Fallback.Element, path+"/fallback", lifted)
+
+		switch len(fallback) {
+		case 1:
+			e.Fallback = NewElementFallback(fallback[0])
+		default:
+			e.Fallback = nil
+			d.record(path+"/fallback", "Element.fallback", "removed fallback element without single supported replacement")
+		}
+	}
+
+	if e.Inlines != nil {
+		inlines := make([]TextRun, 0, len(e.Inlines))
+		for i, inline := range e.Inlines {
+			inlines = append(inlines, d.textRun(inline, fmt.Sprintf("%s/inlines/%d", path, i)))
+		}
+		e.Inlines = inlines
+	}
+
+	// The Columns field is shared by the ColumnSet and Table element types;
+	// the columns of a Table are column definitions without items.
+	if e.Type == TypeElementColumnSet && e.Columns != nil {
+		columns := make([]Column, 0, len(e.Columns))
+		for i, column := range e.Columns {
+			columns = append(columns, d.column(column, fmt.Sprintf("%s/columns/%d", path, i), lifted))
+		}
+		e.Columns = columns
+	}
+
+	if e.Rows != nil {
+		rows := make([]TableRow, 0, len(e.Rows))
+		for i, row := range e.Rows {
+			cells := make([]TableCell, 0, len(row.Cells))
+			for j, cell := range row.Cells {
+				cell.Items = d.elementRefs(cell.Items, fmt.Sprintf("%s/rows/%d/cells/%d/items", path, i, j), lifted)
+				cells = append(cells, cell)
+			}
+			row.Cells = cells
+			rows = append(rows, row)
+		}
+		e.Rows = rows
+	}
+
+	return append(result, e)
+}
+
+// elementRefs rewrites the given collection of element references as used by
+// the Column and TableCell types.
+func (d *downgrader) elementRefs(items []*Element, path string, lifted *[]Action) []*Element {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]*Element, 0, len(items))
+	for i, item := range items {
+		if item == nil {
+			result = append(result, nil)
+
+			continue
+		}
+
+		for _, element := range d.element(*item, fmt.Sprintf("%s/%d", path, i), lifted) {
+			element := element
+			result = append(result, &element)
+		}
+	}
+
+	return result
+}
+
+func (d *downgrader) column(c Column, path string, lifted *[]Action) Column {
+	d.strip("Column.isVisible", path+"/isVisible", c.Visible != nil, func() { c.Visible = nil })
+	d.strip("Column.bleed", path+"/bleed", c.Bleed, func() { c.Bleed = false })
+	d.strip("Column.minHeight", path+"/minHeight", c.MinHeight != "", func() { c.MinHeight = "" })
+	d.strip("Column.verticalContentAlignment", path+"/verticalContentAlignment", c.VerticalContentAlignment != "", func() { c.VerticalContentAlignment = "" })
+	d.strip("Column.backgroundImage", path+"/backgroundImage", c.BackgroundImage != nil, func() { c.BackgroundImage = nil })
+	d.strip("Column.rtl", path+"/rtl", c.Rtl != nil, func() { c.Rtl = nil })
+
+	// The MinHeight field requires the VerticalContentAlignment field.
+	if c.MinHeight != "" && c.VerticalContentAlignment == "" {
+		c.MinHeight = ""
+		d.record(path+"/minHeight", "Column.minHeight", "removed property requiring unsupported verticalContentAlignment")
+	}
+
+	if !d.strip("Column.selectAction", path+"/selectAction", c.SelectAction != nil, func() { c.SelectAction = nil }) &&
+		c.SelectAction != nil {
+		c.SelectAction = d.selectAction(*c.SelectAction, path+"/selectAction")
+	}
+
+	c.BackgroundImage = d.backgroundImage(c.BackgroundImage, path+"/backgroundImage")
+	c.Items = d.elementRefs(c.Items, path+"/items", lifted)
+
+	return c
+}
+
+func (d *downgrader) textRun(tr TextRun, path string) TextRun {
+	d.strip("TextRun.fontType", path+"/fontType", tr.FontType != "", func() { tr.FontType = "" })
+	d.strip("TextRun.underline", path+"/underline", tr.Underline, func() { tr.Underline = false })
+
+	if tr.SelectAction != nil {
+		tr.SelectAction = d.selectAction(*tr.SelectAction, path+"/selectAction")
+	}
+
+	return tr
+}
+
+// textRunActions returns the select actions of the given inlines of an
+// unsupported RichTextBlock element as card actions. The text of the inline
+// is used as the title of actions without one.
+func (d *downgrader) textRunActions(inlines []TextRun, path string) []Action {
+	var actions []Action
+
+	for i, inline := range inlines {
+		if inline.SelectAction == nil {
+			continue
+		}
+
+		selectActionPath := fmt.Sprintf("%s/%d/selectAction", path, i)
+
+		selectAction := d.selectAction(*inline.SelectAction, selectActionPath)
+		if selectAction == nil {
+			continue
+		}
+
+		action := selectActionToAction(*selectAction)
+		if action.Title == "" {
+			action.Title = strings.TrimSpace(inline.Text)
+		}
+
+		actions = append(actions, action)
+		d.record(selectActionPath, TypeElementRichTextBlock, "moved select action of TextRun to card actions")
+	}
+
+	return actions
+}
+
+// actions rewrites the given collection of actions, omitting dropped
+// actions.
+func (d *downgrader) actions(actions []Action, path string) []Action {
+	if actions == nil {
+		return nil
+	}
+
+	result := make([]Action, 0, len(actions))
+	for i, action := range actions {
+		if converted, ok := d.action(action, fmt.Sprintf("%s/%d", path, i)); ok {
+			result = append(result, converted)
+		}
+	}
+
+	return result
+}
+
+// action rewrites the given action. A false value is returned if the action
+// was dropped.
+func (d *downgrader) action(a Action, path string) (Action, bool) {
+	if a.FallbackAction != nil && (d.unsupported(a.Type) || !requiresSatisfied(a.Requires, d.version)) {
+		d.record(path, a.Type, "replaced unsupported action with fallback action")
+
+		return d.action(*a.FallbackAction, path+"/fallback")
+	}
+
+	if d.unsupported(a.Type) {
+		switch {
+		case a.Fallback == TypeFallbackOptionDrop:
+			d.record(path, a.Type, "dropped unsupported action as specified by fallback")
+
+			return Action{}, false
+
+		case a.URL != "":
+			d.record(path, a.Type, "replaced unsupported action with "+TypeActionOpenURL)
+
+			return Action{
+				Type:  TypeActionOpenURL,
+				ID:    a.ID,
+				Title: a.Title,
+				URL:   a.URL,
+			}, true
+
+		default:
+			d.record(path, a.Type, "dropped unsupported action")
+
+			return Action{}, false
+		}
+	}
+
+	d.strip("Action.fallback", path+"/fallback", a.Fallback != "" || a.FallbackAction != nil, func() {
+		a.Fallback = ""
+		a.FallbackAction = nil
+	})
+	d.strip("Action.requires", path+"/requires", len(a.Requires) > 0, func() { a.Requires = nil })
+	d.strip("Action.iconUrl", path+"/iconUrl", a.IconURL != "", func() { a.IconURL = "" })
+	d.strip("Action.style", path+"/style", a.Style != "", func() { a.Style = "" })
+	d.strip("Action.associatedInputs", path+"/associatedInputs", a.AssociatedInputs != "", func() { a.AssociatedInputs = "" })
+	d.strip("Action.tooltip", path+"/tooltip", a.Tooltip != "", func() { a.Tooltip = "" })
+	d.strip("Action.isEnabled", path+"/isEnabled", a.IsEnabled != nil, func() { a.IsEnabled = nil })
+
+	if a.Type == TypeActionShowCard && a.Card != nil {
+		card := d.card(*a.Card, path+"/card")
+		a.Card = &card
+	}
+
+	if a.FallbackAction != nil {
+		if fallback, ok := d.action(*a.FallbackAction, path+"/fallback"); ok {
+			a.FallbackAction = &fallback
+		} else {
+			a.FallbackAction = nil
+		}
+	}
+
+	return a, true
+}
+
+// selectAction rewrites the given ISelectAction. A nil value is returned if
+// the action was dropped.
+func (d *downgrader) selectAction(i ISelectAction, path string) *ISelectAction {
+	if i.FallbackAction != nil && (d.unsupported(i.Type) || !requiresSatisfied(i.Requires, d.version)) {
+		d.record(path, i.Type, "replaced unsupported action with fallback action")
+
+		return d.selectAction(*i.FallbackAction, path+"/fallback")
+	}
+
+	if d.unsupported(i.Type) {
+		switch {
+		case i.Fallback == TypeFallbackOptionDrop:
+			d.record(path, i.Type, "dropped unsupported action as specified by fallback")
+
+			return nil
+
+		case i.URL != "":
+			d.record(path, i.Type, "replaced unsupported action with "+TypeActionOpenURL)
+
+			return &ISelectAction{
+				Type:  TypeActionOpenURL,
+				ID:    i.ID,
+				Title: i.Title,
+				URL:   i.URL,
+			}
+
+		default:
+			d.record(path, i.Type, "dropped unsupported action")
+
+			return nil
+		}
+	}
+
+	d.strip("Action.fallback", path+"/fallback", i.Fallback != "" || i.FallbackAction != nil, func() {
+		i.Fallback = ""
+		i.FallbackAction = nil
+	})
+	d.strip("Action.requires", path+"/requires", len(i.Requires) > 0, func() { i.Requires = nil })
+
+	if i.FallbackAction != nil {
+		i.FallbackAction = d.selectAction(*i.FallbackAction, path+"/fallback")
+	}
+	d.strip("Action.associatedInputs", path+"/associatedInputs", i.AssociatedInputs != "", func() { i.AssociatedInputs = "" })
+	d.strip("Action.tooltip", path+"/tooltip", i.Tooltip != "", func() { i.Tooltip = "" })
+	d.strip("Action.isEnabled", path+"/isEnabled", i.IsEnabled != nil, func() { i.IsEnabled = nil })
+
+	return &i
+}
+
+// tableToContainer converts the given Table element to a Container element
+// with a ColumnSet element for each row of the Table. Cell items are
+// rewritten using their location in the original Table.
+func (d *downgrader) tableToContainer(e Element, path string, lifted *[]Action) Element {
+	showGridLines := e.ShowGridLines == nil || *e.ShowGridLines
+	firstRowAsHeaders := e.FirstRowAsHeaders == nil || *e.FirstRowAsHeaders
+
+	container := Element{
+		Type:      TypeElementContainer,
+		ID:        e.ID,
+		Spacing:   e.Spacing,
+		Separator: e.Separator,
+		Visible:   e.Visible,
+	}
+
+	for i, row := range e.Rows {
+		columnSet := Element{
+			Type:      TypeElementColumnSet,
+			Separator: showGridLines && i > 0,
+		}
+
+		for j, cell := range row.Cells {
+			column := Column{
+				Type:  TypeColumn,
+				Width: ColumnWidthStretch,
+			}
+
+			if j < len(e.Columns) && e.Columns[j].Width != nil {
+				column.Width = e.Columns[j].Width
+			}
+
+			itemsPath := fmt.Sprintf("%s/rows/%d/cells/%d/items", path, i, j)
+			for _, item := range d.elementRefs(cell.Items, itemsPath, lifted) {
+				if item == nil {
+					continue
+				}
+
+				if firstRowAsHeaders && i == 0 &&
+					item.Type == TypeElementTextBlock && item.Weight == "" {
+					item.Weight = WeightBolder
+				}
+
+				column.Items = append(column.Items, item)
+			}
+
+			columnSet.Columns = append(columnSet.Columns, column)
+		}
+
+		container.Items = append(container.Items, columnSet)
+	}
+
+	return container
+}
+
+// selectActionToAction converts the given ISelectAction value to an Action
+// value, including its fallback actions.
+func selectActionToAction(i ISelectAction) Action {
+	action := Action{
+		Type:             i.Type,
+		ID:               i.ID,
+		Title:            i.Title,
+		URL:              i.URL,
+		Fallback:         i.Fallback,
+		Requires:         i.Requires,
+		TargetElements:   i.TargetElements,
+		Tooltip:          i.Tooltip,
+		IsEnabled:        i.IsEnabled,
+		Data:             i.Data,
+		Verb:             i.Verb,
+		AssociatedInputs: i.AssociatedInputs,
+		UnknownFields:    i.UnknownFields,
+	}
+
+	if i.FallbackAction != nil {
+		fallback := selectActionToAction(*i.FallbackAction)
+		action.FallbackAction = &fallback
+	}
+
+	return action
+}
+
+// richTextBlockToTextBlock converts the given RichTextBlock element to a
+// TextBlock element. Bold and italic formatting of inlines is preserved using
+// markdown.
+func richTextBlockToTextBlock(e Element) Element {
+	var text strings.Builder
+
+	for _, inline := range e.Inlines {
+		inlineText := inline.Text
+
+		if inline.Weight == WeightBolder {
+			inlineText = markdownEmphasis(inlineText, "**")
+		}
+
+		if inline.Italic {
+			inlineText = markdownEmphasis(inlineText, "_")
+		}
+
+		text.WriteString(inlineText)
+	}
+
+	return Element{
+		Type:                TypeElementTextBlock,
+		ID:                  e.ID,
+		Text:                text.String(),
+		Wrap:                true,
+		HorizontalAlignment: e.HorizontalAlignment,
+		Spacing:             e.Spacing,
+		Separator:           e.Separator,
+		Visible:             e.Visible,
+	}
+}
+
+// markdownEmphasis wraps the given text in the given markdown marker,
+// keeping leading and trailing whitespace outside of the marker as required
+// for the emphasis to be recognized.
+func markdownEmphasis(text string, marker string) string {
+	trimmed := strings.TrimFunc(text, unicode.IsSpace)
+	if trimmed == "" {
+		return text
+	}
+
+	start := strings.Index(text, trimmed)
+	end := start + len(trimmed)
+
+	return text[:start] + marker + trimmed + marker + text[end:]
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDowngradeCard(t *testing.T) {
+	table, err := NewTableFromTableCells(
+		[][]TableCell{
+			{mustTableCell(t, "Host"), mustTableCell(t, "State")},
+			{mustTableCell(t, "host01"), mustTableCell(t, "DOWN")},
+		},
+		2,
+		true,
+		true,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating table: %v", err)
+	}
+
+	bold := NewTextRun("Alert: ")
+	bold.Weight = WeightBolder
+	linked := NewTextRun("host01 is down")
+	linked.SelectAction = &ISelectAction{Type: TypeActionOpenURL, URL: "https://example.com/host01"}
+	richText, err := NewRichTextBlock(bold, linked)
+	if err != nil {
+		t.Fatalf("unexpected error creating rich text block: %v", err)
+	}
+
+	input := NewInputText("comment", "Comment")
+	input.IsRequired = true
+
+	execute := NewActionExecute("Acknowledge", "ack", nil)
+	execute.URL = "https://example.com/ack"
+
+	actionSet := NewActionSet()
+	actionSet.Actions = []Action{{Type: TypeActionOpenURL, Title: "Runbook", URL: "https://example.com/runbook"}}
+
+	card := NewCard()
+	card.Version = "1.5"
+	card.Body = []Element{table, richText, input, actionSet}
+	card.Actions = []Action{
+		execute,
+		NewActionExecute("Escalate", "escalate", nil),
+	}
+
+	original, err := json.Marshal(card)
+	if err != nil {
+		t.Fatalf("unexpected error encoding card: %v", err)
+	}
+
+	downgraded, changes, err := DowngradeCard(card, 1.0)
+	if err != nil {
+		t.Fatalf("unexpected error downgrading card: %v", err)
+	}
+
+	if after, _ := json.Marshal(card); string(after) != string(original) {
+		t.Errorf("original card was modified")
+	}
+
+	if downgraded.Version != "1.0" {
+		t.Errorf("got version %q; want %q", downgraded.Version, "1.0")
+	}
+
+	if minVersion := downgraded.MinimumVersion(); minVersion > 1.0 {
+		t.Errorf("downgraded card requires version %0.1f; features: %v", minVersion, downgraded.Features())
+	}
+
+	if err := (TopLevelCard{Card: downgraded}).Validate(); err != nil {
+		t.Errorf("unexpected error validating downgraded card: %v", err)
+	}
+
+	wantTypes := []string{
+		TypeElementContainer,
+		TypeElementTextBlock,
+		TypeElementTextBlock,
+		TypeElementInputText,
+	}
+	if len(downgraded.Body) != len(wantTypes) {
+		t.Fatalf("got %d body elements; want %d", len(downgraded.Body), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if got := downgraded.Body[i].Type; got != want {
+			t.Errorf("body element %d: got type %q; want %q", i, got, want)
+		}
+	}
+
+	if got := len(downgraded.Body[0].Items); got != 2 {
+		t.Errorf("got %d ColumnSet elements for table rows; want 2", got)
+	}
+
+	if got, want := downgraded.Body[1].Text, "**Alert:** host01 is down"; got != want {
+		t.Errorf("got rich text %q; want %q", got, want)
+	}
+
+	if got, want := downgraded.Body[2].Text, "Comment"; got != want {
+		t.Errorf("got label text %q; want %q", got, want)
+	}
+
+	if len(downgraded.Actions) != 3 {
+		t.Fatalf("got %d actions; want 3", len(downgraded.Actions))
+	}
+	if got := downgraded.Actions[0]; got.Type != TypeActionOpenURL || got.URL != execute.URL {
+		t.Errorf("got action %+v; want %s replacement", got, TypeActionOpenURL)
+	}
+	if got := downgraded.Actions[1]; got.Title != "host01 is down" || got.URL != linked.SelectAction.URL {
+		t.Errorf("got lifted select action %+v; want %q", got, "host01 is down")
+	}
+	if got := downgraded.Actions[2].Title; got != "Runbook" {
+		t.Errorf("got lifted action %q; want %q", got, "Runbook")
+	}
+
+	paths := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		paths[change.Path] = true
+	}
+
+	for _, path := range []string{"/body/0", "/body/1", "/body/1/inlines/1/selectAction", "/body/2/label", "/body/2/isRequired", "/body/3", "/actions/0", "/actions/1"} {
+		if !paths[path] {
+			t.Errorf("no change recorded for %q; changes: %v", path, changes)
+		}
+	}
+
+	if _, _, err := DowngradeCard(card, 0.9); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
+
+func TestDowngradeCardKeepsSupportedFeatures(t *testing.T) {
+	card := NewCard()
+	card.Version = "1.2"
+	card.Body = []Element{NewTextBlock("body", true)}
+
+	downgraded, changes, err := DowngradeCard(card, 1.4)
+	if err != nil {
+		t.Fatalf("unexpected error downgrading card: %v", err)
+	}
+
+	if len(changes) != 0 {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+
+	if downgraded.Version != "1.2" {
+		t.Errorf("got version %q; want %q", downgraded.Version, "1.2")
+	}
+}
+
+func TestBackgroundImageMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		image BackgroundImage
+		want  string
+	}{
+		{name: "url only", image: BackgroundImage{URL: "https://example.com/bg.png"}, want: `"https://example.com/bg.png"`},
+		{
+			name:  "fill mode",
+			image: NewBackgroundImage("https://example.com/bg.png", BackgroundImageFillModeRepeat),
+			want:  `{"url":"https://example.com/bg.png","fillMode":"repeat"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.image)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustTableCell(t *testing.T, text string) TableCell {
+	t.Helper()
+
+	cell, err := NewTableCellFromElement(NewTextBlock(text, false))
+	if err != nil {
+		t.Fatalf("unexpected error creating table cell: %v", err)
+	}
+
+	return cell
+}
@@ -0,0 +1,303 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+)
+
+// NewElementFallback creates a new ElementFallback which renders the given
+// element in place of an unsupported element.
+func NewElementFallback(element Element) *ElementFallback {
+	return &ElementFallback{
+		Element: &element,
+	}
+}
+
+// NewDropElementFallback creates a new ElementFallback which drops an
+// unsupported element.
+func NewDropElementFallback() *ElementFallback {
+	return &ElementFallback{
+		Drop: true,
+	}
+}
+
+// Validate asserts that fields have valid values.
+func (ef ElementFallback) Validate() error {
+	return ef.validate(false)
+}
+
+// validate asserts that fields have valid values. If skipNested is true, the
+// fallback Element is not validated.
+func (ef ElementFallback) validate(skipNested bool) error {
+	switch {
+	case ef.Drop && ef.Element != nil:
+		return fmt.Errorf(
+			"fields Drop and Element are mutually exclusive for ElementFallback: %w",
+			ErrInvalidFieldValue,
+		)
+
+	case ef.Element != nil && skipNested:
+		return nil
+
+	case ef.Element != nil:
+		return ef.Element.Validate()
+
+	case !ef.Drop:
+		return fmt.Errorf(
+			"one of fields Drop or Element is required for ElementFallback: %w",
+			ErrMissingValue,
+		)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The ElementFallback
+// is encoded as TypeFallbackOptionDrop or as the fallback element.
+func (ef ElementFallback) MarshalJSON() ([]byte, error) {
+	if ef.Element == nil {
+		return json.Marshal(TypeFallbackOptionDrop)
+	}
+
+	// HTML escaping, if enabled, is applied by the caller's encoder.
+	return jsonenc.Marshal(ef.Element, false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Both
+// TypeFallbackOptionDrop and fallback element objects are supported.
+func (ef *ElementFallback) UnmarshalJSON(data []byte) error {
+	var option string
+	if err := json.Unmarshal(data, &option); err == nil {
+		if option != TypeFallbackOptionDrop {
+			return fmt.Errorf(
+				"invalid fallback option %q; expected %q or element: %w",
+				option,
+				TypeFallbackOptionDrop,
+				ErrInvalidFieldValue,
+			)
+		}
+
+		*ef = ElementFallback{Drop: true}
+
+		return nil
+	}
+
+	var element Element
+	if err := json.Unmarshal(data, &element); err != nil {
+		return err
+	}
+
+	*ef = ElementFallback{Element: &element}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The FallbackAction
+// field, if set, is encoded as the fallback property. UnknownFields are
+// included.
+func (a Action) MarshalJSON() ([]byte, error) {
+	// Use an alias type to prevent infinite recursion.
+	type action Action
+
+	var extras []jsonExtras
+
+	if len(a.UnknownFields) > 0 {
+		extras = append(extras, jsonExtras{Fields: a.UnknownFields})
+	}
+
+	if a.FallbackAction != nil {
+		extras = append(extras, jsonExtras{Fallback: a.FallbackAction})
+	}
+
+	// HTML escaping, if enabled, is applied by the caller's encoder.
+	return marshalWithExtras((*action)(&a), extras, false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A fallback action
+// object is decoded into the FallbackAction field, a fallback option into the
+// Fallback field. Unknown properties are recorded in UnknownFields.
+func (a *Action) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type action Action
+
+	var decoded struct {
+		action
+		Fallback json.RawMessage `json:"fallback,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*a = Action(decoded.action)
+
+	unknown, err := unknownJSONFields(data, reflect.TypeOf(Action{}))
+	if err != nil {
+		return err
+	}
+
+	a.UnknownFields = unknown
+
+	if len(decoded.Fallback) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(decoded.Fallback, &a.Fallback); err == nil {
+		return nil
+	}
+
+	var fallback Action
+	if err := json.Unmarshal(decoded.Fallback, &fallback); err != nil {
+		return err
+	}
+
+	a.FallbackAction = &fallback
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The FallbackAction
+// field, if set, is encoded as the fallback property. UnknownFields are
+// included.
+func (i ISelectAction) MarshalJSON() ([]byte, error) {
+	// Use an alias type to prevent infinite recursion.
+	type selectAction ISelectAction
+
+	var extras []jsonExtras
+
+	if len(i.UnknownFields) > 0 {
+		extras = append(extras, jsonExtras{Fields: i.UnknownFields})
+	}
+
+	if i.FallbackAction != nil {
+		extras = append(extras, jsonExtras{Fallback: i.FallbackAction})
+	}
+
+	// HTML escaping, if enabled, is applied by the caller's encoder.
+	return marshalWithExtras((*selectAction)(&i), extras, false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A fallback action
+// object is decoded into the FallbackAction field, a fallback option into the
+// Fallback field. Unknown properties are recorded in UnknownFields.
+func (i *ISelectAction) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type selectAction ISelectAction
+
+	var decoded struct {
+		selectAction
+		Fallback json.RawMessage `json:"fallback,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*i = ISelectAction(decoded.selectAction)
+
+	unknown, err := unknownJSONFields(data, reflect.TypeOf(ISelectAction{}))
+	if err != nil {
+		return err
+	}
+
+	i.UnknownFields = unknown
+
+	if len(decoded.Fallback) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(decoded.Fallback, &i.Fallback); err == nil {
+		return nil
+	}
+
+	var fallback ISelectAction
+	if err := json.Unmarshal(decoded.Fallback, &fallback); err != nil {
+		return err
+	}
+
+	i.FallbackAction = &fallback
+
+	return nil
+}
+
+// assertFallbackActionFields asserts that the Fallback and FallbackAction
+// fields of an action are not used together.
+func assertFallbackActionFields(actionType string, fallback string, hasFallbackAction bool) error {
+	if fallback != "" && hasFallbackAction {
+		return fmt.Errorf(
+			"fields Fallback and FallbackAction are mutually exclusive for action type %s: %w",
+			actionType,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
+
+// assertValidRequires asserts that the given requires map consists of
+// non-empty feature names and versions matching RequiresVersionRegex or
+// RequiresAnyVersion. An empty map is permitted.
+func assertValidRequires(requires map[string]string) error {
+	for feature, version := range requires {
+		if feature == "" {
+			return fmt.Errorf(
+				"empty feature name in Requires: %w",
+				ErrInvalidFieldValue,
+			)
+		}
+
+		if version == RequiresAnyVersion {
+			continue
+		}
+
+		matched, _ := regexp.MatchString(RequiresVersionRegex, version)
+		if !matched {
+			return fmt.Errorf(
+				"invalid version %q for feature %q in Requires;"+
+					" expected %q or version (e.g., %q): %w",
+				version,
+				feature,
+				RequiresAnyVersion,
+				"1.2",
+				ErrInvalidFieldValue,
+			)
+		}
+	}
+
+	return nil
+}
+
+// requiresSatisfied indicates whether the given requires map is satisfied
+// by a host supporting the specified Adaptive Card schema version. Only the
+// RequiresFeatureAdaptiveCards feature is evaluated; other features are
+// assumed to be supported.
+func requiresSatisfied(requires map[string]string, version float64) bool {
+	required, ok := requires[RequiresFeatureAdaptiveCards]
+	if !ok || required == RequiresAnyVersion {
+		return true
+	}
+
+	var major, minor int
+
+	// Additional version components (e.g., "1.2.0") are ignored.
+	if _, err := fmt.Sscanf(required+".0", "%d.%d", &major, &minor); err != nil {
+		return true
+	}
+
+	versionMajor := int(version)
+	versionMinor := int(math.Round((version - float64(versionMajor)) * 10))
+
+	return major < versionMajor || (major == versionMajor && minor <= versionMinor)
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+)
+
+func TestFallbackJSON(t *testing.T) {
+	table := NewTable()
+	table.Fallback = NewElementFallback(NewTextBlock("table not supported", true))
+	table.Requires = map[string]string{RequiresFeatureAdaptiveCards: "1.5"}
+
+	image := NewImage("https://example.com/logo.png", "logo")
+	image.Fallback = NewDropElementFallback()
+
+	execute := NewActionExecute("Acknowledge", "ack", nil)
+	execute.FallbackAction = &Action{Type: TypeActionOpenURL, Title: "Acknowledge", URL: "https://example.com/ack"}
+
+	toggle := Action{Type: TypeActionToggleVisibility, Title: "Details", Fallback: TypeFallbackOptionDrop}
+
+	card := NewCard()
+	card.Body = []Element{table, image}
+	card.Actions = []Action{execute, toggle}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		t.Fatalf("unexpected error encoding card: %v", err)
+	}
+
+	for _, want := range []string{
+		`"fallback":{"type":"TextBlock","text":"table not supported","wrap":true}`,
+		`"requires":{"adaptiveCards":"1.5"}`,
+		`"fallback":"drop"`,
+		`"fallback":{"type":"Action.OpenUrl","title":"Acknowledge","url":"https://example.com/ack"}`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("encoded card %s does not contain %s", data, want)
+		}
+	}
+
+	var decoded Card
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error decoding card: %v", err)
+	}
+
+	switch {
+	case decoded.Body[0].Fallback == nil || decoded.Body[0].Fallback.Element == nil:
+		t.Errorf("fallback element not decoded: %+v", decoded.Body[0].Fallback)
+	case decoded.Body[0].Fallback.Element.Text != "table not supported":
+		t.Errorf("got fallback text %q", decoded.Body[0].Fallback.Element.Text)
+	}
+
+	if decoded.Body[1].Fallback == nil || !decoded.Body[1].Fallback.Drop {
+		t.Errorf("drop fallback not decoded: %+v", decoded.Body[1].Fallback)
+	}
+
+	if got := decoded.Actions[0].FallbackAction; got == nil || got.URL != "https://example.com/ack" {
+		t.Errorf("fallback action not decoded: %+v", got)
+	}
+
+	if got := decoded.Actions[1]; got.Fallback != TypeFallbackOptionDrop || got.FallbackAction != nil {
+		t.Errorf("fallback option not decoded: %+v", got)
+	}
+
+	var fallback ElementFallback
+	if err := json.Unmarshal([]byte(`"TextBlock"`), &fallback); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
+
+func TestFallbackJSONHTMLEscaping(t *testing.T) {
+	action := Action{
+		Type:           TypeActionOpenURL,
+		Title:          "<at>Jane</at>",
+		URL:            "https://example.com",
+		FallbackAction: &Action{Type: TypeActionOpenURL, Title: "<at>Jane</at>", URL: "https://example.com"},
+	}
+
+	escaped, err := json.Marshal(action)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Contains(string(escaped), "<at>") {
+		t.Errorf("expected escaped HTML characters; got %s", escaped)
+	}
+
+	unescaped, err := jsonenc.Marshal(action, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Count(string(unescaped), "<at>") != 2 {
+		t.Errorf("expected unescaped HTML characters; got %s", unescaped)
+	}
+}
+
+func TestActionJSONRoundTrip(t *testing.T) {
+	const action = `{"type": "Action.Execute", "title": "Ack", "verb": "ack", "custom": 1,
+		"fallback": {"type": "Action.OpenUrl", "title": "Ack", "url": "https://example.com/ack", "other": true}}`
+
+	var decodedAction Action
+	if err := json.Unmarshal([]byte(action), &decodedAction); err != nil {
+		t.Fatalf("unexpected error decoding action: %v", err)
+	}
+
+	var decodedSelectAction ISelectAction
+	if err := json.Unmarshal([]byte(action), &decodedSelectAction); err != nil {
+		t.Fatalf("unexpected error decoding select action: %v", err)
+	}
+
+	want := decodeJSONValue(t, []byte(action))
+
+	for _, v := range []interface{}{decodedAction, &decodedSelectAction} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("unexpected error encoding %T: %v", v, err)
+		}
+
+		if got := decodeJSONValue(t, data); !reflect.DeepEqual(got, want) {
+			t.Errorf("round trip mismatch for %T\ngot:  %s\nwant: %s", v, data, action)
+		}
+	}
+}
+
+func TestFallbackValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		element Element
+		wantErr error
+	}{
+		{
+			name:    "drop",
+			element: Element{Type: TypeElementTextBlock, Text: "text", Fallback: NewDropElementFallback()},
+		},
+		{
+			name:    "invalid fallback element",
+			element: Element{Type: TypeElementTextBlock, Text: "text", Fallback: NewElementFallback(Element{Type: "Unknown"})},
+			wantErr: ErrInvalidType,
+		},
+		{
+			name:    "empty fallback",
+			element: Element{Type: TypeElementTextBlock, Text: "text", Fallback: &ElementFallback{}},
+			wantErr: ErrMissingValue,
+		},
+		{
+			name: "requires",
+			element: Element{Type: TypeElementTextBlock, Text: "text", Requires: map[string]string{
+				RequiresFeatureAdaptiveCards: "1.2",
+				"acme.feature":               RequiresAnyVersion,
+			}},
+		},
+		{
+			name:    "invalid requires version",
+			element: Element{Type: TypeElementTextBlock, Text: "text", Requires: map[string]string{RequiresFeatureAdaptiveCards: "v1"}},
+			wantErr: ErrInvalidFieldValue,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.element.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	action := Action{
+		Type:           TypeActionOpenURL,
+		URL:            "https://example.com",
+		Fallback:       TypeFallbackOptionDrop,
+		FallbackAction: &Action{Type: TypeActionOpenURL, URL: "https://example.com"},
+	}
+	if err := action.Validate(); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
+
+func TestDowngradeCardUsesFallback(t *testing.T) {
+	table := NewTable()
+	table.Fallback = NewElementFallback(NewTextBlock("table not supported", true))
+
+	container := Element(NewContainer())
+	container.Items = []Element{NewTextBlock("new layout", true)}
+	container.Requires = map[string]string{RequiresFeatureAdaptiveCards: "1.4"}
+	container.Fallback = NewDropElementFallback()
+
+	execute := NewActionExecute("Acknowledge", "ack", nil)
+	execute.FallbackAction = &Action{Type: TypeActionOpenURL, Title: "Acknowledge", URL: "https://example.com/ack"}
+
+	card := NewCard()
+	card.Body = []Element{table, container}
+	card.Actions = []Action{execute}
+
+	downgraded, changes, err := DowngradeCard(card, 1.3)
+	if err != nil {
+		t.Fatalf("unexpected error downgrading card: %v", err)
+	}
+
+	if len(downgraded.Body) != 1 || downgraded.Body[0].Text != "table not supported" {
+		t.Errorf("got body %+v; want fallback element only", downgraded.Body)
+	}
+
+	if len(downgraded.Actions) != 1 || downgraded.Actions[0].URL != "https://example.com/ack" {
+		t.Errorf("got actions %+v; want fallback action", downgraded.Actions)
+	}
+
+	if len(changes) != 3 {
+		t.Errorf("got %d changes; want 3: %v", len(changes), changes)
+	}
+}
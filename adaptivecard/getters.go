@@ -37,10 +37,22 @@ func supportedElementTypes() []string {
 	}
 }
 
-// supportedSizeValues returns a list of valid Size values for applicable
-// Element types. This list is intended to be used for validation and display
+// supportedSizeValues returns a list of valid Size values for the specified
+// element type. This list is intended to be used for validation and display
 // purposes.
-func supportedSizeValues() []string {
+func supportedSizeValues(elementType string) []string {
+	switch elementType {
+	case TypeElementImage:
+		return supportedImageSizeValues()
+	default:
+		return supportedTextSizeValues()
+	}
+}
+
+// supportedTextSizeValues returns a list of valid Size values for text in
+// applicable Element types. This list is intended to be used for validation
+// and display purposes.
+func supportedTextSizeValues() []string {
 	// https://adaptivecards.io/explorer/TextBlock.html
 	return []string{
 		SizeSmall,
@@ -79,6 +91,17 @@ func supportedColorValues() []string {
 	}
 }
 
+// supportedFontTypeValues returns a list of valid FontType values for text in
+// applicable Element types. This list is intended to be used for validation
+// and display purposes.
+func supportedFontTypeValues() []string {
+	// https://adaptivecards.io/explorer/TextRun.html
+	return []string{
+		FontTypeDefault,
+		FontTypeMonospace,
+	}
+}
+
 // supportedSpacingValues returns a list of valid Spacing values for Element
 // types. This list is intended to be used for validation and display
 // purposes.
@@ -122,6 +145,19 @@ func supportedVerticalContentAlignmentValues() []string {
 	}
 }
 
+// supportedBackgroundImageFillModeValues returns a list of valid FillMode
+// values for a BackgroundImage. This list is intended to be used for
+// validation and display purposes.
+func supportedBackgroundImageFillModeValues() []string {
+	// https://adaptivecards.io/explorer/BackgroundImage.html
+	return []string{
+		BackgroundImageFillModeCover,
+		BackgroundImageFillModeRepeatHorizontally,
+		BackgroundImageFillModeRepeatVertically,
+		BackgroundImageFillModeRepeat,
+	}
+}
+
 // supportedActionValues accepts a value indicating the maximum Adaptive Card
 // schema version supported and returns a list of valid Action types. This
 // list is intended to be used for validation and display purposes.
@@ -138,7 +174,8 @@ func supportedActionValues(version float64) []string {
 		TypeActionToggleVisibility,
 
 		// Action.Submit is not supported for Adaptive Cards in Incoming
-		// Webhooks.
+		// Webhooks. It is accepted for bot delivery only; see the
+		// supportedEndpointActionValues() function.
 		//
 		// TypeActionSubmit,
 	}
@@ -177,7 +214,8 @@ func supportedISelectActionValues(version float64) []string {
 		TypeActionToggleVisibility,
 
 		// Action.Submit is not supported for Adaptive Cards in Incoming
-		// Webhooks.
+		// Webhooks. It is accepted for bot delivery only; see the
+		// supportedEndpointActionValues() function.
 		//
 		// TypeActionSubmit,
 
@@ -205,6 +243,72 @@ func supportedISelectActionValues(version float64) []string {
 	return supportedValues
 }
 
+// supportedActionStyleValues returns a list of valid Style field values for
+// Action types. This list is intended to be used for validation and display
+// purposes.
+func supportedActionStyleValues() []string {
+	// https://adaptivecards.io/explorer/Action.Execute.html
+	return []string{
+		ActionStyleDefault,
+		ActionStylePositive,
+		ActionStyleDestructive,
+	}
+}
+
+// supportedAssociatedInputsValues returns a list of valid AssociatedInputs
+// field values for the Action.Submit and Action.Execute types. This list is
+// intended to be used for validation and display purposes.
+func supportedAssociatedInputsValues() []string {
+	// https://adaptivecards.io/explorer/Action.Submit.html
+	return []string{
+		AssociatedInputsAuto,
+		AssociatedInputsNone,
+	}
+}
+
+// supportedEndpointValues returns a list of valid Endpoint values. This list
+// is intended to be used for validation and display purposes.
+func supportedEndpointValues() []string {
+	return []string{
+		string(EndpointWebhook),
+		string(EndpointBot),
+	}
+}
+
+// supportedEndpointActionValues returns a list of Action types deliverable
+// through the specified Endpoint. This list is intended to be used for
+// validation and display purposes.
+func supportedEndpointActionValues(endpoint Endpoint) []string {
+	switch endpoint {
+	case EndpointBot:
+		return []string{
+			TypeActionExecute,
+			TypeActionOpenURL,
+			TypeActionShowCard,
+			TypeActionSubmit,
+			TypeActionToggleVisibility,
+		}
+
+	// "For Adaptive Cards in Incoming Webhooks, all native Adaptive Card
+	// schema elements, except Action.Submit, are fully supported. The
+	// supported actions are Action.OpenURL, Action.ShowCard,
+	// Action.ToggleVisibility, and Action.Execute."
+	//
+	// https://docs.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/cards-reference
+	case EndpointWebhook:
+		return []string{
+			TypeActionExecute,
+			TypeActionOpenURL,
+			TypeActionShowCard,
+			TypeActionToggleVisibility,
+		}
+
+	// Unsupported endpoints are indicated by an explicit empty list.
+	default:
+		return []string{}
+	}
+}
+
 // supportedAttachmentLayoutValues returns a list of valid AttachmentLayout
 // values for Message type. This list is intended to be used for validation
 // and display purposes.
@@ -253,6 +357,44 @@ func supportedImageStyleValues() []string {
 	}
 }
 
+// supportedImageSizeValues returns a list of valid Size field values for the
+// Image element type. This list is intended to be used for validation and
+// display purposes.
+func supportedImageSizeValues() []string {
+	// https://adaptivecards.io/explorer/Image.html
+	return []string{
+		ImageSizeAuto,
+		ImageSizeStretch,
+		ImageSizeSmall,
+		ImageSizeMedium,
+		ImageSizeLarge,
+	}
+}
+
+// supportedImageSetSizeValues returns a list of valid ImageSize field values
+// for the ImageSet element type. This list is intended to be used for
+// validation and display purposes.
+func supportedImageSetSizeValues() []string {
+	// https://adaptivecards.io/explorer/ImageSet.html
+	return []string{
+		ImageSizeAuto,
+		ImageSizeSmall,
+		ImageSizeMedium,
+		ImageSizeLarge,
+	}
+}
+
+// supportedImageHeightValues returns a list of valid keyword Height field
+// values for the Image element type. A specific pixel height is also
+// supported. This list is intended to be used for validation and display
+// purposes.
+func supportedImageHeightValues() []string {
+	return []string{
+		ImageHeightAuto,
+		ImageHeightStretch,
+	}
+}
+
 // supportedChoiceInputStyleValues returns a list of valid Style field values
 // for ChoiceInput related element types (e.g., Input.ChoiceSet) This list is
 // intended to be used for validation and display purposes.
@@ -311,6 +453,38 @@ func supportedMSTeamsWidthValues() []string {
 	}
 }
 
+// supportedMentionedTypeValues returns a list of valid Type field values for
+// the Mentioned type. The Type field is empty for user, channel and team
+// mentions. This list is intended to be used for validation and display
+// purposes.
+func supportedMentionedTypeValues() []string {
+	return []string{
+		MentionedTypeTag,
+	}
+}
+
+// supportedConversationIdentityTypeValues returns a list of valid
+// ConversationIdentityType values for Mentioned. This list is intended to be
+// used for validation and display purposes.
+func supportedConversationIdentityTypeValues() []string {
+	return []string{
+		ConversationIdentityTypeChannel,
+		ConversationIdentityTypeTeam,
+	}
+}
+
+// supportedMentionRefPrefixValues returns a list of valid mention reference
+// prefixes as used by ResolveMentions. This list is intended to be used for
+// validation and display purposes.
+func supportedMentionRefPrefixValues() []string {
+	return []string{
+		MentionRefPrefixUser,
+		MentionRefPrefixTag,
+		MentionRefPrefixChannel,
+		MentionRefPrefixTeam,
+	}
+}
+
 // supportedActionFallbackValues accepts a value indicating the maximum
 // Adaptive Card schema version supported and returns a list of valid Action
 // Fallback types. This list is intended to be used for validation and display
@@ -338,3 +512,136 @@ func supportedISelectActionFallbackValues(version float64) []string {
 
 	return supportedValues
 }
+
+// supportedFeatureVersions returns a map of Adaptive Card schema features
+// (see the Feature type) to the schema version which introduced them. This
+// map is intended to be used for validation and display purposes.
+//
+// Features not listed are available in all schema versions.
+//
+//   - https://adaptivecards.io/explorer/
+//   - https://adaptivecards.io/schemas/adaptive-card.json
+func supportedFeatureVersions() map[string]float64 {
+	return map[string]float64{
+		// Element types.
+		TypeElementActionSet:        1.2,
+		TypeElementColumnSet:        1.0,
+		TypeElementContainer:        1.0,
+		TypeElementFactSet:          1.0,
+		TypeElementImage:            1.0,
+		TypeElementImageSet:         1.0,
+		TypeElementInputChoiceSet:   1.0,
+		TypeElementInputDate:        1.0,
+		TypeElementInputNumber:      1.0,
+		TypeElementInputText:        1.0,
+		TypeElementInputTime:        1.0,
+		TypeElementInputToggle:      1.0,
+		TypeElementMedia:            1.1,
+		TypeElementRichTextBlock:    1.2,
+		TypeElementTable:            1.5,
+		TypeElementTextBlock:        1.0,
+		TypeElementTextRun:          1.2,
+		TypeElementMSTeamsCodeBlock: 1.0,
+
+		// Action types.
+		TypeActionExecute:          ActionExecuteMinCardVersionRequired,
+		TypeActionOpenURL:          1.0,
+		TypeActionShowCard:         1.0,
+		TypeActionSubmit:           1.0,
+		TypeActionToggleVisibility: 1.2,
+
+		// Card properties.
+		"AdaptiveCard.selectAction":             1.1,
+		"AdaptiveCard.minHeight":                1.2,
+		"AdaptiveCard.verticalContentAlignment": 1.1,
+		"AdaptiveCard.refresh":                  1.4,
+		"AdaptiveCard.authentication":           1.4,
+		"AdaptiveCard.rtl":                      1.5,
+
+		// BackgroundImage object properties; a plain URL is supported by all
+		// schema versions.
+		"BackgroundImage.fillMode":            1.2,
+		"BackgroundImage.horizontalAlignment": 1.2,
+		"BackgroundImage.verticalAlignment":   1.2,
+
+		// Element properties.
+		"Element.fallback":  1.2,
+		"Element.isVisible": 1.2,
+		"Element.requires":  1.2,
+
+		"TextBlock.fontType": 1.2,
+		"TextBlock.style":    1.5,
+
+		"TextRun.fontType":  1.2,
+		"TextRun.underline": 1.3,
+
+		"Image.backgroundColor": 1.1,
+		"Image.height":          1.1,
+		"Image.selectAction":    1.1,
+		"Image.width":           1.1,
+
+		"Container.backgroundImage":          1.2,
+		"Container.style.accent":             1.2,
+		"Container.style.attention":          1.2,
+		"Container.style.good":               1.2,
+		"Container.style.warning":            1.2,
+		"Container.bleed":                    1.2,
+		"Container.minHeight":                1.2,
+		"Container.rtl":                      1.5,
+		"Container.selectAction":             1.1,
+		"Container.verticalContentAlignment": 1.1,
+
+		"ColumnSet.bleed":        1.2,
+		"ColumnSet.minHeight":    1.2,
+		"ColumnSet.selectAction": 1.1,
+		"ColumnSet.style":        1.2,
+
+		"Column.backgroundImage":          1.2,
+		"Column.bleed":                    1.2,
+		"Column.isVisible":                1.2,
+		"Column.minHeight":                1.2,
+		"Column.rtl":                      1.5,
+		"Column.selectAction":             1.1,
+		"Column.style.accent":             1.2,
+		"Column.style.attention":          1.2,
+		"Column.style.good":               1.2,
+		"Column.style.warning":            1.2,
+		"Column.verticalContentAlignment": 1.1,
+
+		"Input.errorMessage":             1.3,
+		"Input.isRequired":               1.3,
+		"Input.label":                    1.3,
+		"Input.ChoiceSet.style.filtered": 1.5,
+		"Input.ChoiceSet.wrap":           1.2,
+		"Input.Text.inlineAction":        1.2,
+		"Input.Text.regex":               1.3,
+		"Input.Text.style.password":      1.5,
+		"Input.Toggle.wrap":              1.2,
+
+		// Action properties.
+		"Action.associatedInputs": 1.3,
+		"Action.fallback":         1.2,
+		"Action.iconUrl":          1.1,
+		"Action.isEnabled":        1.5,
+		"Action.requires":         1.2,
+		"Action.style":            1.2,
+		"Action.tooltip":          1.5,
+	}
+}
+
+// teamsFeatureVersionOverrides returns a map of Adaptive Card schema features
+// to the card version at which Microsoft Teams renders them when this is
+// earlier than the schema version which introduced the feature.
+//
+// Power Automate Workflow connectors reject cards declaring a version later
+// than AdaptiveCardMaxVersion, while Microsoft Teams renders these features
+// in cards declaring that version. This package relies on this behavior
+// (e.g., NewTitleTextBlock and the Table helpers). The overrides are applied
+// by validation and minimum version computation (see TeamsFeatureVersion),
+// but not by DowngradeCard.
+func teamsFeatureVersionOverrides() map[string]float64 {
+	return map[string]float64{
+		TypeElementTable:  AdaptiveCardMaxVersion,
+		"TextBlock.style": AdaptiveCardMaxVersion,
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NewImage creates a new Image element using the given URL and alternate
+// text. The alternate text is optional, but recommended for accessibility
+// purposes.
+func NewImage(url string, altText string) Element {
+	image := Element{
+		Type:    TypeElementImage,
+		URL:     url,
+		AltText: altText,
+	}
+
+	return image
+}
+
+// NewPersonImage creates a new Image element using the given URL and
+// alternate text. The image is cropped to a circle as is common for avatars.
+func NewPersonImage(url string, altText string) Element {
+	image := NewImage(url, altText)
+	image.Style = ImageStylePerson
+
+	return image
+}
+
+// NewImageSet creates a new ImageSet element using the given (optional)
+// image size and Image elements. An error is returned if any of the given
+// elements fail validation or are not Image elements.
+func NewImageSet(imageSize string, images ...Element) (Element, error) {
+	imageSet := Element{
+		Type:      TypeElementImageSet,
+		ImageSize: imageSize,
+	}
+
+	if err := imageSet.AddImage(images...); err != nil {
+		return Element{}, err
+	}
+
+	return imageSet, nil
+}
+
+// AddImage adds one or many Image elements to an ImageSet element. An error
+// is returned if an element is not an Image, if an Image fails validation or
+// if AddImage is called on an unsupported Element type.
+func (e *Element) AddImage(images ...Element) error {
+	if e.Type != TypeElementImageSet {
+		return fmt.Errorf(
+			"unsupported element type %s; expected %s: %w",
+			e.Type,
+			TypeElementImageSet,
+			ErrInvalidType,
+		)
+	}
+
+	for _, image := range images {
+		if image.Type != TypeElementImage {
+			return fmt.Errorf(
+				"unsupported element type %s for ImageSet; expected %s: %w",
+				image.Type,
+				TypeElementImage,
+				ErrInvalidType,
+			)
+		}
+
+		if err := image.Validate(); err != nil {
+			return err
+		}
+	}
+
+	e.Images = append(e.Images, images...)
+
+	return nil
+}
+
+// assertImageValues asserts that the fields specific to an Image element have
+// valid values.
+func assertImageValues(e Element) error {
+	if err := assertValidPixelSizeOrEmptyValue(e.Width); err != nil {
+		return fmt.Errorf("invalid Width for element type %s: %w", e.Type, err)
+	}
+
+	height := strings.TrimSpace(e.Height)
+	if !isImageHeightKeyword(height) {
+		if err := assertValidPixelSizeOrEmptyValue(height); err != nil {
+			return fmt.Errorf(
+				"invalid Height for element type %s; expected one of keywords %q or pixel height: %w",
+				e.Type,
+				strings.Join(supportedImageHeightValues(), ","),
+				err,
+			)
+		}
+	}
+
+	if e.BackgroundColor != "" {
+		matched, _ := regexp.MatchString(ImageBackgroundColorRegex, e.BackgroundColor)
+		if !matched {
+			return fmt.Errorf(
+				"invalid BackgroundColor %q for element type %s;"+
+					" expected hex color value (e.g., %s): %w",
+				e.BackgroundColor,
+				e.Type,
+				"#DDDDDD",
+				ErrInvalidFieldValue,
+			)
+		}
+	}
+
+	return nil
+}
+
+// isImageHeightKeyword indicates whether the given value is one of the
+// supported keyword Height values for an Image element.
+func isImageHeightKeyword(val string) bool {
+	for _, keyword := range supportedImageHeightValues() {
+		if val == keyword {
+			return true
+		}
+	}
+
+	return false
+}
+
+// assertImageSetValues asserts that an ImageSet element contains at least
+// one element and that all elements are valid Image elements. If skipNested
+// is true, only the type of the elements is asserted.
+func assertImageSetValues(e Element, skipNested bool) error {
+	if len(e.Images) == 0 {
+		return fmt.Errorf(
+			"required field Images is empty for element type %s: %w",
+			e.Type,
+			ErrMissingValue,
+		)
+	}
+
+	for _, image := range e.Images {
+		if image.Type != TypeElementImage {
+			return fmt.Errorf(
+				"unsupported element type %s for ImageSet; expected %s: %w",
+				image.Type,
+				TypeElementImage,
+				ErrInvalidType,
+			)
+		}
+
+		if skipNested {
+			continue
+		}
+
+		if err := image.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestImageElementValidation(t *testing.T) {
+	const imageURL = "https://example.com/avatar.png"
+
+	image := func(fn func(e *Element)) Element {
+		e := NewPersonImage(imageURL, "avatar")
+		fn(&e)
+		return e
+	}
+
+	tests := []struct {
+		name    string
+		element Element
+		wantErr error
+	}{
+		{name: "person image", element: NewPersonImage(imageURL, "avatar")},
+		{name: "missing url", element: NewImage("", "avatar"), wantErr: ErrMissingValue},
+		{name: "stretch size", element: image(func(e *Element) { e.Size = ImageSizeStretch })},
+		{name: "text size", element: image(func(e *Element) { e.Size = SizeExtraLarge }), wantErr: ErrInvalidFieldValue},
+		{name: "pixel dimensions", element: image(func(e *Element) { e.Width, e.Height = "32px", "32px" })},
+		{name: "keyword height", element: image(func(e *Element) { e.Height = ImageHeightStretch })},
+		{name: "invalid width", element: image(func(e *Element) { e.Width = "auto" }), wantErr: ErrInvalidFieldValue},
+		{name: "invalid height", element: image(func(e *Element) { e.Height = "32" }), wantErr: ErrInvalidFieldValue},
+		{name: "background color", element: image(func(e *Element) { e.BackgroundColor = "#DDDDDD" })},
+		{name: "invalid background color", element: image(func(e *Element) { e.BackgroundColor = "grey" }), wantErr: ErrInvalidFieldValue},
+		{name: "invalid style", element: image(func(e *Element) { e.Style = "round" }), wantErr: ErrInvalidFieldValue},
+		{name: "select action", element: image(func(e *Element) {
+			e.SelectAction = &ISelectAction{Type: TypeActionOpenURL, URL: "https://example.com"}
+		})},
+		{name: "imageset", element: Element{
+			Type:      TypeElementImageSet,
+			ImageSize: ImageSizeSmall,
+			Images:    []Element{NewImage(imageURL, "")},
+		}},
+		{name: "imageset empty", element: Element{Type: TypeElementImageSet}, wantErr: ErrMissingValue},
+		{name: "imageset stretch", element: Element{
+			Type:      TypeElementImageSet,
+			ImageSize: ImageSizeStretch,
+			Images:    []Element{NewImage(imageURL, "")},
+		}, wantErr: ErrInvalidFieldValue},
+		{name: "imageset non-image", element: Element{
+			Type:   TypeElementImageSet,
+			Images: []Element{NewTextBlock("text", false)},
+		}, wantErr: ErrInvalidType},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.element.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := NewImageSet(ImageSizeMedium, NewTextBlock("text", false)); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidType)
+	}
+}
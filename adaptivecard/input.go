@@ -0,0 +1,488 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// NewInputText creates a new Input.Text element using the given ID and
+// optional label.
+func NewInputText(id string, label string) Element {
+	input := Element{
+		Type:  TypeElementInputText,
+		ID:    id,
+		Label: label,
+	}
+
+	return input
+}
+
+// NewInputNumber creates a new Input.Number element using the given ID and
+// optional label.
+func NewInputNumber(id string, label string) Element {
+	input := Element{
+		Type:  TypeElementInputNumber,
+		ID:    id,
+		Label: label,
+	}
+
+	return input
+}
+
+// NewInputDate creates a new Input.Date element using the given ID and
+// optional label.
+func NewInputDate(id string, label string) Element {
+	input := Element{
+		Type:  TypeElementInputDate,
+		ID:    id,
+		Label: label,
+	}
+
+	return input
+}
+
+// NewInputTime creates a new Input.Time element using the given ID and
+// optional label.
+func NewInputTime(id string, label string) Element {
+	input := Element{
+		Type:  TypeElementInputTime,
+		ID:    id,
+		Label: label,
+	}
+
+	return input
+}
+
+// NewInputToggle creates a new Input.Toggle element using the given ID and
+// (required) title.
+func NewInputToggle(id string, title string) Element {
+	input := Element{
+		Type:  TypeElementInputToggle,
+		ID:    id,
+		Title: title,
+	}
+
+	return input
+}
+
+// NewInputChoiceSet creates a new Input.ChoiceSet element using the given ID,
+// optional label and Choice values. An error is returned if any of the
+// given Choice values fail validation.
+func NewInputChoiceSet(id string, label string, choices ...Choice) (Element, error) {
+	input := Element{
+		Type:  TypeElementInputChoiceSet,
+		ID:    id,
+		Label: label,
+	}
+
+	if err := input.AddChoice(choices...); err != nil {
+		return Element{}, err
+	}
+
+	return input, nil
+}
+
+// NewChoice creates a new Choice using the given title and value. An error
+// is returned if either value is empty.
+func NewChoice(title string, value string) (Choice, error) {
+	choice := Choice{
+		Title: title,
+		Value: value,
+	}
+
+	if err := choice.Validate(); err != nil {
+		return Choice{}, err
+	}
+
+	return choice, nil
+}
+
+// AddChoice adds one or many Choice values to an Input.ChoiceSet element. An
+// error is returned if a Choice fails validation or if AddChoice is called on
+// an unsupported Element type.
+func (e *Element) AddChoice(choices ...Choice) error {
+	if e.Type != TypeElementInputChoiceSet {
+		return fmt.Errorf(
+			"unsupported element type %s; expected %s: %w",
+			e.Type,
+			TypeElementInputChoiceSet,
+			ErrInvalidType,
+		)
+	}
+
+	for _, choice := range choices {
+		if err := choice.Validate(); err != nil {
+			return err
+		}
+	}
+
+	e.Choices = append(e.Choices, choices...)
+
+	return nil
+}
+
+// Validate asserts that fields have valid values.
+func (c Choice) Validate() error {
+	switch {
+	case c.Title == "":
+		return fmt.Errorf(
+			"required field Title is empty for Choice: %w",
+			ErrMissingValue,
+		)
+	case c.Value == "":
+		return fmt.Errorf(
+			"required field Value is empty for Choice: %w",
+			ErrMissingValue,
+		)
+	}
+
+	return nil
+}
+
+// Validate asserts that the collection of Choice values are all valid.
+func (c Choices) Validate() error {
+	for _, choice := range c {
+		if err := choice.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assertInputStringValue asserts that the Value field of an Input element is
+// either unset or a string value.
+func assertInputStringValue(e Element) (string, error) {
+	switch v := e.Value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return "", fmt.Errorf(
+			"invalid Value %v (%T) for element type %s; expected string: %w",
+			v,
+			v,
+			e.Type,
+			ErrInvalidFieldValue,
+		)
+	}
+}
+
+// assertInputTextValues asserts that the fields specific to an Input.Text
+// element have valid values.
+func assertInputTextValues(e Element) error {
+	value, err := assertInputStringValue(e)
+	if err != nil {
+		return err
+	}
+
+	if e.MaxLength < 0 {
+		return fmt.Errorf(
+			"invalid MaxLength %d for element type %s; expected positive value: %w",
+			e.MaxLength,
+			e.Type,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	if e.MaxLength > 0 && len([]rune(value)) > e.MaxLength {
+		return fmt.Errorf(
+			"length of Value %q exceeds MaxLength %d for element type %s: %w",
+			value,
+			e.MaxLength,
+			e.Type,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	if e.Regex != "" {
+		if _, err := regexp.Compile(e.Regex); err != nil {
+			return fmt.Errorf(
+				"invalid Regex %q for element type %s: %v: %w",
+				e.Regex,
+				e.Type,
+				err,
+				ErrInvalidFieldValue,
+			)
+		}
+	}
+
+	return nil
+}
+
+// inputNumber converts the given value to a float64 if it is one of the
+// numeric types supported for the Value, Min or Max fields of an
+// Input.Number element. Decoded JSON numbers are supported.
+func inputNumber(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// assertInputNumberValues asserts that the Value, Min and Max fields of an
+// Input.Number element are either unset or numbers and that the given range
+// is valid.
+func assertInputNumberValues(e Element) error {
+	fields := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "Value", value: e.Value},
+		{name: "Min", value: e.Min},
+		{name: "Max", value: e.Max},
+	}
+
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+
+		if _, ok := inputNumber(field.value); !ok {
+			return fmt.Errorf(
+				"invalid %s %v (%T) for element type %s; expected number: %w",
+				field.name,
+				field.value,
+				field.value,
+				e.Type,
+				ErrInvalidFieldValue,
+			)
+		}
+	}
+
+	value, hasValue := inputNumber(e.Value)
+	min, hasMin := inputNumber(e.Min)
+	max, hasMax := inputNumber(e.Max)
+
+	return assertInputRange(
+		e.Type,
+		fmt.Sprint(e.Value), hasValue,
+		fmt.Sprint(e.Min), hasMin,
+		fmt.Sprint(e.Max), hasMax,
+		func(a, b int) bool {
+			nums := []float64{value, min, max}
+			return nums[a] < nums[b]
+		},
+	)
+}
+
+// assertInputTemporalValues asserts that the Value, Min and Max fields of an
+// Input.Date or Input.Time element are either unset or strings in the given
+// layout and that the given range is valid.
+func assertInputTemporalValues(e Element, layout string) error {
+	fields := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "Value", value: e.Value},
+		{name: "Min", value: e.Min},
+		{name: "Max", value: e.Max},
+	}
+
+	var times [3]time.Time
+	var isSet [3]bool
+
+	for i, field := range fields {
+		if field.value == nil {
+			continue
+		}
+
+		s, ok := field.value.(string)
+		if !ok {
+			return fmt.Errorf(
+				"invalid %s %v (%T) for element type %s; expected string: %w",
+				field.name,
+				field.value,
+				field.value,
+				e.Type,
+				ErrInvalidFieldValue,
+			)
+		}
+
+		if s == "" {
+			continue
+		}
+
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return fmt.Errorf(
+				"invalid %s %q for element type %s; expected format %s: %w",
+				field.name,
+				s,
+				e.Type,
+				layout,
+				ErrInvalidFieldValue,
+			)
+		}
+
+		times[i] = t
+		isSet[i] = true
+	}
+
+	return assertInputRange(
+		e.Type,
+		fmt.Sprint(e.Value), isSet[0],
+		fmt.Sprint(e.Min), isSet[1],
+		fmt.Sprint(e.Max), isSet[2],
+		func(a, b int) bool {
+			return times[a].Before(times[b])
+		},
+	)
+}
+
+// assertInputRange asserts that the min value is not greater than the max
+// value and that the initial value falls within the range. The less function
+// compares values by index: 0 for the value, 1 for min and 2 for max.
+func assertInputRange(
+	elementType string,
+	value string, hasValue bool,
+	min string, hasMin bool,
+	max string, hasMax bool,
+	less func(a, b int) bool,
+) error {
+	const (
+		valueIdx = 0
+		minIdx   = 1
+		maxIdx   = 2
+	)
+
+	switch {
+	case hasMin && hasMax && less(maxIdx, minIdx):
+		return fmt.Errorf(
+			"invalid range for element type %s; Min %s is greater than Max %s: %w",
+			elementType,
+			min,
+			max,
+			ErrInvalidFieldValue,
+		)
+
+	case hasValue && hasMin && less(valueIdx, minIdx):
+		return fmt.Errorf(
+			"invalid Value %s for element type %s; less than Min %s: %w",
+			value,
+			elementType,
+			min,
+			ErrInvalidFieldValue,
+		)
+
+	case hasValue && hasMax && less(maxIdx, valueIdx):
+		return fmt.Errorf(
+			"invalid Value %s for element type %s; greater than Max %s: %w",
+			value,
+			elementType,
+			max,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
+
+// assertInputChoiceSetValues asserts that an Input.ChoiceSet element has at
+// least one Choice and that the initial value (if set) refers to the values
+// of the available choices.
+func assertInputChoiceSetValues(e Element) error {
+	if len(e.Choices) == 0 {
+		return fmt.Errorf(
+			"required field Choices is empty for element type %s: %w",
+			e.Type,
+			ErrMissingValue,
+		)
+	}
+
+	value, err := assertInputStringValue(e)
+	if err != nil {
+		return err
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	selected := []string{value}
+	if e.IsMultiSelect {
+		selected = strings.Split(value, ChoiceInputValueSeparator)
+	}
+
+	for _, s := range selected {
+		var found bool
+		for _, choice := range e.Choices {
+			if choice.Value == strings.TrimSpace(s) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf(
+				"invalid Value %q for element type %s; no Choice with that value: %w",
+				s,
+				e.Type,
+				ErrValueNotFound,
+			)
+		}
+	}
+
+	return nil
+}
+
+// assertInputToggleValues asserts that the initial value (if set) of an
+// Input.Toggle element matches either the ValueOn or ValueOff field values
+// (or their defaults).
+func assertInputToggleValues(e Element) error {
+	value, err := assertInputStringValue(e)
+	if err != nil {
+		return err
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	valueOn := e.ValueOn
+	if valueOn == "" {
+		valueOn = ToggleInputValueOnDefault
+	}
+
+	valueOff := e.ValueOff
+	if valueOff == "" {
+		valueOff = ToggleInputValueOffDefault
+	}
+
+	if value != valueOn && value != valueOff {
+		return fmt.Errorf(
+			"invalid Value %q for element type %s; expected %q or %q: %w",
+			value,
+			e.Type,
+			valueOn,
+			valueOff,
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
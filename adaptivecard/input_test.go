@@ -0,0 +1,84 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestInputElementValidation(t *testing.T) {
+	choiceSet, err := NewInputChoiceSet(
+		"priority",
+		"Priority",
+		Choice{Title: "Low", Value: "low"},
+		Choice{Title: "High", Value: "high"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating ChoiceSet: %v", err)
+	}
+
+	withValue := func(e Element, value interface{}) Element {
+		e.Value = value
+		return e
+	}
+
+	withRange := func(e Element, min interface{}, max interface{}) Element {
+		e.Min = min
+		e.Max = max
+		return e
+	}
+
+	multiSelect := withValue(choiceSet, "low,high")
+	multiSelect.IsMultiSelect = true
+
+	tests := []struct {
+		name    string
+		element Element
+		wantErr error
+	}{
+		{name: "text", element: NewInputText("comment", "Comment")},
+		{name: "text missing id", element: NewInputText("", "Comment"), wantErr: ErrMissingValue},
+		{name: "text invalid regex", element: func() Element {
+			e := NewInputText("comment", "")
+			e.Regex = "("
+			return e
+		}(), wantErr: ErrInvalidFieldValue},
+		{name: "number range", element: withValue(withRange(NewInputNumber("count", ""), 1, 10), 5)},
+		{name: "number decoded json", element: withValue(NewInputNumber("count", ""), json.Number("2.5"))},
+		{name: "number out of range", element: withValue(withRange(NewInputNumber("count", ""), 1, 10), 11), wantErr: ErrInvalidFieldValue},
+		{name: "number string value", element: withValue(NewInputNumber("count", ""), "5"), wantErr: ErrInvalidFieldValue},
+		{name: "date range", element: withValue(withRange(NewInputDate("due", ""), "2026-01-01", "2026-12-31"), "2026-06-01")},
+		{name: "date inverted range", element: withRange(NewInputDate("due", ""), "2026-12-31", "2026-01-01"), wantErr: ErrInvalidFieldValue},
+		{name: "date invalid format", element: withValue(NewInputDate("due", ""), "06/01/2026"), wantErr: ErrInvalidFieldValue},
+		{name: "time", element: withValue(NewInputTime("at", ""), "13:30")},
+		{name: "time invalid format", element: withValue(NewInputTime("at", ""), "1:30 PM"), wantErr: ErrInvalidFieldValue},
+		{name: "choiceset", element: withValue(choiceSet, "high")},
+		{name: "choiceset multiselect", element: multiSelect},
+		{name: "choiceset multiple values", element: withValue(choiceSet, "low,high"), wantErr: ErrValueNotFound},
+		{name: "choiceset no choices", element: Element{Type: TypeElementInputChoiceSet, ID: "x"}, wantErr: ErrMissingValue},
+		{name: "toggle", element: withValue(NewInputToggle("ack", "Acknowledge"), ToggleInputValueOnDefault)},
+		{name: "toggle missing title", element: NewInputToggle("ack", ""), wantErr: ErrMissingValue},
+		{name: "toggle unknown value", element: withValue(NewInputToggle("ack", "Acknowledge"), "yes"), wantErr: ErrInvalidFieldValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.element.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cardTarget is a TargetElement reference recorded by cardIDIntegrityErrors.
+type cardTarget struct {
+	elementID string
+	path      string
+}
+
+// assertCardIDIntegrity asserts that the IDs of the elements, columns and
+// actions of the given Card tree (see Card.Walk) are unique and that the
+// TargetElements of Action.ToggleVisibility actions and select actions refer
+// to an element or column of the Card tree. The error identifies the path of
+// the offending value.
+func assertCardIDIntegrity(c Card) error {
+	if errs := cardIDIntegrityErrors(c); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// cardIDIntegrityErrors returns a ValidationError for every duplicate ID and
+// unresolved TargetElement of the given Card tree (see
+// assertCardIDIntegrity).
+//
+// Fallback content may reuse the IDs of the content it replaces and is
+// excluded from the uniqueness check.
+func cardIDIntegrityErrors(c Card) []*ValidationError {
+	ids := make(map[string]string)
+	elementIDs := make(map[string]bool)
+
+	var errs []*ValidationError
+	var targets []cardTarget
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		var id string
+		var targetElements []TargetElement
+		var isElement bool
+
+		switch {
+		case node.Element != nil:
+			id, isElement = node.Element.ID, true
+		case node.Column != nil:
+			id, isElement = node.Column.ID, true
+		case node.Action != nil:
+			id, targetElements = node.Action.ID, node.Action.TargetElements
+		case node.SelectAction != nil:
+			id, targetElements = node.SelectAction.ID, node.SelectAction.TargetElements
+		}
+
+		for i, targetElement := range targetElements {
+			targets = append(targets, cardTarget{
+				elementID: targetElement.ElementID,
+				path:      fmt.Sprintf("%s/targetElements/%d/elementId", node.Path, i),
+			})
+		}
+
+		if id == "" {
+			return nil
+		}
+
+		if isElement {
+			elementIDs[id] = true
+		}
+
+		if strings.Contains(node.Path, "/fallback") {
+			return nil
+		}
+
+		if firstPath, ok := ids[id]; ok {
+			errs = append(errs, &ValidationError{
+				Path:  node.Path + "/id",
+				Field: "ID",
+				Rule:  ValidationRuleUnique,
+				Value: id,
+				Err: fmt.Errorf(
+					"ID %q is already used at %q: %w",
+					id,
+					firstPath+"/id",
+					ErrDuplicateID,
+				),
+			})
+
+			return nil
+		}
+
+		ids[id] = node.Path
+
+		return nil
+	})
+
+	for _, target := range targets {
+		switch {
+		case target.elementID == "":
+			errs = append(errs, &ValidationError{
+				Path:  target.path,
+				Field: "ElementID",
+				Rule:  ValidationRuleRequired,
+				Err: fmt.Errorf(
+					"required ElementID is empty for TargetElement: %w",
+					ErrMissingValue,
+				),
+			})
+
+		case !elementIDs[target.elementID]:
+			errs = append(errs, &ValidationError{
+				Path:  target.path,
+				Field: "ElementID",
+				Rule:  ValidationRuleReference,
+				Value: target.elementID,
+				Err: fmt.Errorf(
+					"ElementID %q does not match the ID of an element or column: %w",
+					target.elementID,
+					ErrTargetNotFound,
+				),
+			})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCardIDIntegrity(t *testing.T) {
+	textBlock := func(id string) Element {
+		e := NewTextBlock("text", true)
+		e.ID = id
+
+		return e
+	}
+
+	toggle := func(ids ...string) Action {
+		a := Action{Type: TypeActionToggleVisibility, Title: "Toggle"}
+		for _, id := range ids {
+			a.TargetElements = append(a.TargetElements, TargetElement{ElementID: id})
+		}
+
+		return a
+	}
+
+	topLevelCard := func(fn func(c *Card)) TopLevelCard {
+		c := NewCard()
+		c.Body = []Element{textBlock("details")}
+		fn(&c)
+
+		return TopLevelCard{Card: c}
+	}
+
+	tests := []struct {
+		name     string
+		card     TopLevelCard
+		wantErr  error
+		wantPath string
+	}{
+		{name: "valid", card: topLevelCard(func(c *Card) {
+			c.Actions = []Action{toggle("details")}
+		})},
+		{name: "duplicate element ID", card: topLevelCard(func(c *Card) {
+			container := Element(NewContainer())
+			container.Items = []Element{textBlock("details")}
+			c.Body = append(c.Body, container)
+		}), wantErr: ErrDuplicateID, wantPath: "/body/1/items/0/id"},
+		{name: "duplicate action ID", card: topLevelCard(func(c *Card) {
+			a := toggle("details")
+			a.ID = "details"
+			c.Actions = []Action{a}
+		}), wantErr: ErrDuplicateID, wantPath: "/actions/0/id"},
+		{name: "duplicate ID in show card", card: topLevelCard(func(c *Card) {
+			nested := NewCard()
+			nested.Body = []Element{textBlock("details")}
+			c.Actions = []Action{{Type: TypeActionShowCard, Title: "More", Card: &nested}}
+		}), wantErr: ErrDuplicateID, wantPath: "/actions/0/card/body/0/id"},
+		{name: "fallback reuses ID", card: topLevelCard(func(c *Card) {
+			c.Body[0].Fallback = NewElementFallback(textBlock("details"))
+		})},
+		{name: "unknown target", card: topLevelCard(func(c *Card) {
+			c.Actions = []Action{toggle("details", "history")}
+		}), wantErr: ErrTargetNotFound, wantPath: "/actions/0/targetElements/1/elementId"},
+		{name: "unknown select action target", card: topLevelCard(func(c *Card) {
+			c.Body[0].Type = TypeElementContainer
+			c.Body[0].Items = []Element{textBlock("inner")}
+			c.Body[0].SelectAction = &ISelectAction{
+				Type:           TypeActionToggleVisibility,
+				TargetElements: []TargetElement{{ElementID: "missing"}},
+			}
+		}), wantErr: ErrTargetNotFound, wantPath: "/body/0/selectAction/targetElements/0/elementId"},
+		{name: "target in show card", card: topLevelCard(func(c *Card) {
+			nested := NewCard()
+			nested.Body = []Element{textBlock("history")}
+			c.Actions = []Action{
+				{Type: TypeActionShowCard, Title: "More", Card: &nested},
+				toggle("history"),
+			}
+		})},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.card.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			case tt.wantPath != "" && !strings.Contains(err.Error(), tt.wantPath):
+				t.Errorf("error %v does not identify path %q", err, tt.wantPath)
+			}
+		})
+	}
+}
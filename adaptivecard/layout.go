@@ -0,0 +1,96 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+	"github.com/atc0005/go-teams-notify/v2/internal/validator"
+)
+
+// NewBackgroundImage creates a new BackgroundImage using the given URL and
+// (optional) fill mode.
+func NewBackgroundImage(url string, fillMode string) BackgroundImage {
+	return BackgroundImage{
+		URL:      url,
+		FillMode: fillMode,
+	}
+}
+
+// Validate asserts that fields have valid values.
+func (bi BackgroundImage) Validate() error {
+	v := validator.Validator{}
+
+	v.NotEmptyValue(bi.URL, "URL", "BackgroundImage", ErrMissingValue)
+
+	v.InListIfFieldValNotEmpty(
+		bi.FillMode,
+		"FillMode",
+		"BackgroundImage",
+		supportedBackgroundImageFillModeValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.InListIfFieldValNotEmpty(
+		bi.HorizontalAlignment,
+		"HorizontalAlignment",
+		"BackgroundImage",
+		supportedHorizontalAlignmentValues(),
+		ErrInvalidFieldValue,
+	)
+
+	v.InListIfFieldValNotEmpty(
+		bi.VerticalAlignment,
+		"VerticalAlignment",
+		"BackgroundImage",
+		supportedVerticalContentAlignmentValues(),
+		ErrInvalidFieldValue,
+	)
+
+	return v.Err()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. In addition to
+// BackgroundImage objects, the schema permits a plain URL string; this is
+// decoded as a BackgroundImage with only the URL field set.
+func (bi *BackgroundImage) UnmarshalJSON(data []byte) error {
+	var url string
+	if err := json.Unmarshal(data, &url); err == nil {
+		*bi = BackgroundImage{URL: url}
+
+		return nil
+	}
+
+	// Use an alias type to prevent infinite recursion.
+	type backgroundImage BackgroundImage
+
+	var decoded backgroundImage
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*bi = BackgroundImage(decoded)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. A BackgroundImage with
+// only the URL field set is encoded as a plain URL string as supported by all
+// schema versions; the object form requires schema version 1.2.
+func (bi BackgroundImage) MarshalJSON() ([]byte, error) {
+	// HTML escaping, if enabled, is applied by the caller's encoder.
+	if bi.FillMode == "" && bi.HorizontalAlignment == "" && bi.VerticalAlignment == "" {
+		return jsonenc.Marshal(bi.URL, false)
+	}
+
+	// Use an alias type to prevent infinite recursion.
+	type backgroundImage BackgroundImage
+
+	return jsonenc.Marshal(backgroundImage(bi), false)
+}
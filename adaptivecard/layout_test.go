@@ -0,0 +1,134 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLayoutValidation(t *testing.T) {
+	bg := NewBackgroundImage("https://example.com/bg.png", BackgroundImageFillModeRepeat)
+
+	container := func(fn func(e *Element)) Element {
+		e := Element(NewContainer())
+		e.Items = []Element{NewTextBlock("banner", true)}
+		fn(&e)
+		return e
+	}
+
+	column := func(fn func(c *Column)) Element {
+		c := NewColumn()
+		fn(&c)
+		columnSet := NewColumnSet()
+		columnSet.Columns = []Column{c}
+		return columnSet
+	}
+
+	tests := []struct {
+		name    string
+		element Element
+		wantErr error
+	}{
+		{name: "emphasis container", element: container(func(e *Element) {
+			e.Style = ContainerStyleEmphasis
+			e.Bleed = true
+			e.MinHeight = "80px"
+			e.VerticalContentAlignment = VerticalAlignmentCenter
+			e.BackgroundImage = &bg
+		})},
+		{name: "container min height without alignment", element: container(func(e *Element) {
+			e.MinHeight = "80px"
+		}), wantErr: ErrMissingValue},
+		{name: "container invalid min height", element: container(func(e *Element) {
+			e.MinHeight = "80"
+			e.VerticalContentAlignment = VerticalAlignmentTop
+		}), wantErr: ErrInvalidFieldValue},
+		{name: "container background image missing url", element: container(func(e *Element) {
+			e.BackgroundImage = &BackgroundImage{}
+		}), wantErr: ErrMissingValue},
+		{name: "good column", element: column(func(c *Column) {
+			c.Style = ContainerStyleGood
+			c.Spacing = SpacingMedium
+			c.Separator = true
+			c.MinHeight = "40px"
+			c.VerticalContentAlignment = VerticalAlignmentBottom
+			c.BackgroundImage = &bg
+		})},
+		{name: "column invalid style", element: column(func(c *Column) { c.Style = "loud" }), wantErr: ErrInvalidFieldValue},
+		{name: "column invalid spacing", element: column(func(c *Column) { c.Spacing = "huge" }), wantErr: ErrInvalidFieldValue},
+		{name: "column invalid fill mode", element: column(func(c *Column) {
+			c.BackgroundImage = &BackgroundImage{URL: "https://example.com/bg.png", FillMode: "tile"}
+		}), wantErr: ErrInvalidFieldValue},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.element.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var decoded Column
+	if err := json.Unmarshal([]byte(`{"type":"Column","backgroundImage":"https://example.com/bg.png"}`), &decoded); err != nil {
+		t.Fatalf("unexpected error decoding column: %v", err)
+	}
+
+	if decoded.BackgroundImage == nil || decoded.BackgroundImage.URL != "https://example.com/bg.png" {
+		t.Errorf("unexpected decoded background image: %+v", decoded.BackgroundImage)
+	}
+}
+
+func TestBackgroundImageHTMLEscaping(t *testing.T) {
+	const url = "https://example.com/bg.png?a=1&b=2"
+
+	for _, bi := range []BackgroundImage{
+		{URL: url},
+		{URL: url, FillMode: "repeat"},
+	} {
+		c := NewCard()
+		c.Body = []Element{NewTextBlock("summary", true)}
+		c.BackgroundImage = &bi
+
+		msg, err := NewMessageFromCard(c)
+		if err != nil {
+			t.Fatalf("unexpected error creating message: %v", err)
+		}
+
+		msg.DisableHTMLEscape = true
+
+		payload, err := msg.PayloadSnapshot()
+		if err != nil {
+			t.Fatalf("unexpected error encoding message: %v", err)
+		}
+
+		if !strings.Contains(string(payload), url) {
+			t.Errorf("payload %s does not contain unescaped URL %q", payload, url)
+		}
+
+		msg.DisableHTMLEscape = false
+
+		payload, err = msg.PayloadSnapshot()
+		if err != nil {
+			t.Fatalf("unexpected error encoding message: %v", err)
+		}
+
+		if strings.Contains(string(payload), url) {
+			t.Errorf("payload %s contains URL %q without HTML escaping", payload, url)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+)
+
+// LintSeverity ranks a LintFinding. Higher values are more severe.
+type LintSeverity int
+
+// Supported LintSeverity values.
+const (
+	// LintSeverityInfo indicates a finding which may be worth reviewing.
+	LintSeverityInfo LintSeverity = 1
+
+	// LintSeverityWarning indicates a finding which is likely to degrade the
+	// rendering or accessibility of a card.
+	LintSeverityWarning LintSeverity = 2
+
+	// LintSeverityError indicates a finding which is likely to break the
+	// card as rendered by Microsoft Teams (e.g., actions which are not
+	// functional).
+	LintSeverityError LintSeverity = 3
+)
+
+// Lint rules applied by Message.Lint.
+const (
+	// LintRuleTooManyActions reports cards and ActionSet elements with more
+	// than LintConfig.MaxActions actions. Actions beyond the limit are not
+	// displayed by Microsoft Teams.
+	LintRuleTooManyActions string = "tooManyActions"
+
+	// LintRuleImageAltText reports Image elements without alternate text.
+	// Screen readers are unable to describe these images.
+	LintRuleImageAltText string = "imageAltText"
+
+	// LintRuleMissingHeading reports cards without a heading-style TextBlock
+	// (see TextBlockStyleHeading). Headings allow screen reader users to
+	// navigate the card.
+	LintRuleMissingHeading string = "missingHeading"
+
+	// LintRuleDeepNesting reports elements nested more than
+	// LintConfig.MaxNestingDepth levels deep. Deeply nested layouts render
+	// poorly on mobile clients.
+	LintRuleDeepNesting string = "deepNesting"
+
+	// LintRuleUnwrappedLongText reports TextBlock elements with text longer
+	// than LintConfig.MaxUnwrappedTextLength characters which do not wrap.
+	// This text is truncated by Microsoft Teams.
+	LintRuleUnwrappedLongText string = "unwrappedLongText"
+
+	// LintRuleFactSetMarkdown reports FactSet elements with markdown in fact
+	// values. Markdown support in FactSet values is inconsistent across
+	// Microsoft Teams clients.
+	LintRuleFactSetMarkdown string = "factSetMarkdown"
+
+	// LintRuleActionNotDeliverable reports actions which are not supported
+	// by the LintConfig.Endpoint (e.g., Action.Submit via EndpointWebhook).
+	// These actions do not function when selected.
+	LintRuleActionNotDeliverable string = "actionNotDeliverable"
+
+	// LintRuleMentionTextFormat reports mentions whose text does not consist
+	// solely of the mention markup (see MentionTextFormatTemplate). Microsoft
+	// Teams does not match this text with the text of the card.
+	LintRuleMentionTextFormat string = "mentionTextFormat"
+
+	// LintRuleMentionWithoutEntity reports mention text (see
+	// MentionTextRegex) in TextBlock and FactSet elements without a matching
+	// mention. This text is displayed as-is and does not notify anyone.
+	LintRuleMentionWithoutEntity string = "mentionWithoutEntity"
+)
+
+// Default LintConfig values.
+const (
+	LintDefaultMaxNestingDepth        int = 4
+	LintDefaultMaxUnwrappedTextLength int = 80
+)
+
+// lintMarkdownRegex matches common markdown syntax: emphasis, links and list
+// items.
+const lintMarkdownRegex string = `\*\*[^*]+\*\*|__[^_]+__|\[[^\]]+\]\([^)]+\)|(?m)^\s*(?:[-*+]|\d+\.)\s`
+
+// LintConfig configures the lint pass performed by Message.Lint. Zero values
+// are replaced with their defaults.
+type LintConfig struct {
+	// Endpoint is the endpoint used to deliver the Message. Defaults to
+	// EndpointWebhook.
+	Endpoint Endpoint
+
+	// MaxActions is the maximum number of actions for a card or ActionSet.
+	// Defaults to TeamsActionsDisplayLimit.
+	MaxActions int
+
+	// MaxNestingDepth is the maximum nesting depth of elements. Body
+	// elements have a depth of zero. Defaults to LintDefaultMaxNestingDepth.
+	MaxNestingDepth int
+
+	// MaxUnwrappedTextLength is the maximum length of TextBlock text which
+	// does not wrap. Defaults to LintDefaultMaxUnwrappedTextLength.
+	MaxUnwrappedTextLength int
+
+	// MinSeverity is the minimum severity of reported findings. Defaults to
+	// LintSeverityInfo.
+	MinSeverity LintSeverity
+
+	// DisabledRules is the list of suppressed lint rules (e.g.,
+	// LintRuleMissingHeading).
+	DisabledRules []string
+}
+
+// LintFinding is an issue reported by Message.Lint. Findings do not
+// invalidate a Message.
+type LintFinding struct {
+	// Rule is the lint rule reporting the finding (e.g.,
+	// LintRuleImageAltText).
+	Rule string
+
+	// Severity is the severity of the finding.
+	Severity LintSeverity
+
+	// Path is the JSON Pointer (RFC 6901) location of the offending value
+	// relative to the Message (e.g., "/attachments/0/content/body/1").
+	Path string
+
+	// Message describes the finding.
+	Message string
+}
+
+// LintFindings is a collection of LintFinding values ordered by descending
+// severity.
+type LintFindings []LintFinding
+
+// NewLintConfig creates a LintConfig with default values.
+func NewLintConfig() LintConfig {
+	return LintConfig{
+		Endpoint:               EndpointWebhook,
+		MaxActions:             TeamsActionsDisplayLimit,
+		MaxNestingDepth:        LintDefaultMaxNestingDepth,
+		MaxUnwrappedTextLength: LintDefaultMaxUnwrappedTextLength,
+		MinSeverity:            LintSeverityInfo,
+	}
+}
+
+// String returns the name of the severity.
+func (s LintSeverity) String() string {
+	switch s {
+	case LintSeverityInfo:
+		return "info"
+	case LintSeverityWarning:
+		return "warning"
+	case LintSeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("LintSeverity(%d)", int(s))
+	}
+}
+
+// String returns a description of the finding.
+func (lf LintFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", lf.Severity, lf.Path, lf.Message, lf.Rule)
+}
+
+// HighestSeverity returns the highest severity of the findings or zero if
+// there are no findings.
+func (lf LintFindings) HighestSeverity() LintSeverity {
+	var highest LintSeverity
+
+	for _, finding := range lf {
+		if finding.Severity > highest {
+			highest = finding.Severity
+		}
+	}
+
+	return highest
+}
+
+// Lint inspects the Message for issues which do not invalidate the Message
+// but affect how its cards are rendered by Microsoft Teams or their
+// accessibility. Findings are returned ordered by descending severity. If
+// config is nil, the default configuration is used (see NewLintConfig).
+//
+// Lint does not validate the Message; see Message.Validate.
+func (m Message) Lint(config *LintConfig) LintFindings {
+	cfg := NewLintConfig()
+	if config != nil {
+		cfg = config.withDefaults()
+	}
+
+	l := linter{config: cfg}
+
+	for i := range m.Attachments {
+		card := m.Attachments[i].Content.Card
+		l.card(&card, fmt.Sprintf("/attachments/%d/content", i))
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		return l.findings[i].Severity > l.findings[j].Severity
+	})
+
+	return l.findings
+}
+
+// withDefaults returns a copy of the LintConfig with zero values replaced by
+// their defaults.
+func (lc LintConfig) withDefaults() LintConfig {
+	defaults := NewLintConfig()
+
+	if lc.Endpoint == "" {
+		lc.Endpoint = defaults.Endpoint
+	}
+
+	if lc.MaxActions <= 0 {
+		lc.MaxActions = defaults.MaxActions
+	}
+
+	if lc.MaxNestingDepth <= 0 {
+		lc.MaxNestingDepth = defaults.MaxNestingDepth
+	}
+
+	if lc.MaxUnwrappedTextLength <= 0 {
+		lc.MaxUnwrappedTextLength = defaults.MaxUnwrappedTextLength
+	}
+
+	if lc.MinSeverity == 0 {
+		lc.MinSeverity = defaults.MinSeverity
+	}
+
+	return lc
+}
+
+// linter records the findings of a lint pass.
+type linter struct {
+	config   LintConfig
+	findings LintFindings
+}
+
+// report records a finding unless the rule is disabled or the severity is
+// below the configured minimum.
+func (l *linter) report(rule string, severity LintSeverity, path string, format string, a ...interface{}) {
+	if severity < l.config.MinSeverity || goteamsnotify.InList(rule, l.config.DisabledRules, false) {
+		return
+	}
+
+	l.findings = append(l.findings, LintFinding{
+		Rule:     rule,
+		Severity: severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// card lints the given top-level Card tree located at the given path.
+func (l *linter) card(c *Card, path string) {
+	markdown := regexp.MustCompile(lintMarkdownRegex)
+	mentionText := regexp.MustCompile("^" + MentionTextRegex + "$")
+	deliverable := supportedEndpointActionValues(l.config.Endpoint)
+
+	hasHeading := false
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		nodePath := path + node.Path
+
+		switch {
+		case node.Card != nil:
+			l.actionCount(len(node.Card.Actions), nodePath+"/actions")
+
+			for i, mention := range node.Card.MSTeams.Entities {
+				if !mentionText.MatchString(mention.Text) {
+					l.report(
+						LintRuleMentionTextFormat, LintSeverityWarning,
+						fmt.Sprintf("%s/msteams/entities/%d/text", nodePath, i),
+						"mention text %q does not match the expected format %q",
+						mention.Text, MentionTextFormatTemplate,
+					)
+				}
+			}
+
+		case node.Element != nil:
+			e := node.Element
+
+			if depth := strings.Count(node.Path, "/items/"); depth == l.config.MaxNestingDepth+1 {
+				l.report(
+					LintRuleDeepNesting, LintSeverityWarning, nodePath,
+					"element is nested %d levels deep; maximum is %d",
+					depth, l.config.MaxNestingDepth,
+				)
+			}
+
+			// Mentions are recorded by the top-level card only, including
+			// those for the Cards of Action.ShowCard actions.
+			if e.Type == TypeElementTextBlock || e.Type == TypeElementFactSet {
+				for _, text := range unmatchedMentionTexts(*e, c.MSTeams.Entities) {
+					l.report(
+						LintRuleMentionWithoutEntity, LintSeverityWarning, nodePath,
+						"mention text %q has no matching mention and does not notify anyone",
+						text,
+					)
+				}
+			}
+
+			switch e.Type {
+			case TypeElementTextBlock:
+				if isLintHeading(*e) {
+					hasHeading = true
+				}
+
+				if !e.Wrap && len([]rune(e.Text)) > l.config.MaxUnwrappedTextLength {
+					l.report(
+						LintRuleUnwrappedLongText, LintSeverityWarning, nodePath,
+						"text of %d characters does not wrap and may be truncated",
+						len([]rune(e.Text)),
+					)
+				}
+
+			case TypeElementImage:
+				if strings.TrimSpace(e.AltText) == "" {
+					l.report(
+						LintRuleImageAltText, LintSeverityWarning, nodePath,
+						"image has no alternate text",
+					)
+				}
+
+			case TypeElementFactSet:
+				for i, fact := range e.Facts {
+					if markdown.MatchString(fact.Value) {
+						l.report(
+							LintRuleFactSetMarkdown, LintSeverityInfo,
+							fmt.Sprintf("%s/facts/%d/value", nodePath, i),
+							"fact value contains markdown which may not be rendered",
+						)
+					}
+				}
+
+			case TypeElementActionSet:
+				l.actionCount(len(e.Actions), nodePath+"/actions")
+			}
+
+		case node.Action != nil:
+			l.actionDeliverable(node.Action.Type, deliverable, nodePath)
+
+		case node.SelectAction != nil:
+			l.actionDeliverable(node.SelectAction.Type, deliverable, nodePath)
+		}
+
+		return nil
+	})
+
+	if !hasHeading {
+		l.report(
+			LintRuleMissingHeading, LintSeverityInfo, path,
+			"card has no TextBlock with style %q", TextBlockStyleHeading,
+		)
+	}
+}
+
+// actionCount reports a collection of actions exceeding the configured
+// maximum.
+func (l *linter) actionCount(count int, path string) {
+	if count > l.config.MaxActions {
+		l.report(
+			LintRuleTooManyActions, LintSeverityWarning, path,
+			"%d actions exceed the display limit of %d; additional actions are not displayed",
+			count, l.config.MaxActions,
+		)
+	}
+}
+
+// actionDeliverable reports an action type which is not deliverable through
+// the configured Endpoint.
+func (l *linter) actionDeliverable(actionType string, deliverable []string, path string) {
+	if !goteamsnotify.InList(actionType, deliverable, false) {
+		l.report(
+			LintRuleActionNotDeliverable, LintSeverityError, path,
+			"action type %s is not supported for %s delivery",
+			actionType, l.config.Endpoint,
+		)
+	}
+}
+
+// isLintHeading indicates whether the given TextBlock is styled as a
+// heading, either explicitly or using a large, bold font.
+func isLintHeading(e Element) bool {
+	if e.Style == TextBlockStyleHeading {
+		return true
+	}
+
+	return e.Weight == WeightBolder && (e.Size == SizeLarge || e.Size == SizeExtraLarge)
+}
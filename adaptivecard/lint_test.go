@@ -0,0 +1,125 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func lintTestMessage(t *testing.T) *Message {
+	t.Helper()
+
+	factSet := Element(NewFactSet())
+	factSet.Facts = []Fact{
+		{Title: "Host", Value: "host01"},
+		{Title: "Runbook", Value: "[open](https://example.com/runbook)"},
+	}
+
+	nested := NewTextBlock("nested", true)
+	for i := 0; i < LintDefaultMaxNestingDepth+1; i++ {
+		container := Element(NewContainer())
+		container.Items = []Element{nested}
+		nested = container
+	}
+
+	c := NewCard()
+	c.Body = []Element{
+		NewTextBlock(strings.Repeat("x", LintDefaultMaxUnwrappedTextLength+1), false),
+		NewImage("https://example.com/status.png", ""),
+		factSet,
+		nested,
+		NewTextBlock("paging <at>sre-oncall</at>", true),
+	}
+
+	for i := 0; i < TeamsActionsDisplayLimit+1; i++ {
+		c.Actions = append(c.Actions, Action{Type: TypeActionOpenURL, Title: "Open", URL: "https://example.com"})
+	}
+
+	c.Actions[0] = Action{Type: TypeActionSubmit, Title: "Acknowledge"}
+
+	c.MSTeams.Entities = []Mention{{
+		Type:      TypeMention,
+		Text:      "Jane Doe",
+		Mentioned: Mentioned{ID: "jane.doe@example.com", Name: "Jane Doe"},
+	}}
+
+	msg, err := NewMessageFromCard(c)
+	if err != nil {
+		t.Fatalf("unexpected error creating message: %v", err)
+	}
+
+	return msg
+}
+
+func TestMessageLint(t *testing.T) {
+	msg := lintTestMessage(t)
+
+	var got []string
+	for _, finding := range msg.Lint(nil) {
+		got = append(got, finding.Rule+" "+finding.Path)
+	}
+
+	want := []string{
+		"actionNotDeliverable /attachments/0/content/actions/0",
+		"tooManyActions /attachments/0/content/actions",
+		"mentionTextFormat /attachments/0/content/msteams/entities/0/text",
+		"unwrappedLongText /attachments/0/content/body/0",
+		"imageAltText /attachments/0/content/body/1",
+		"deepNesting /attachments/0/content/body/3/items/0/items/0/items/0/items/0/items/0",
+		"mentionWithoutEntity /attachments/0/content/body/4",
+		"factSetMarkdown /attachments/0/content/body/2/facts/1/value",
+		"missingHeading /attachments/0/content",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got findings\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestMessageLintConfig(t *testing.T) {
+	msg := lintTestMessage(t)
+
+	findings := msg.Lint(&LintConfig{
+		Endpoint:    EndpointBot,
+		MaxActions:  10,
+		MinSeverity: LintSeverityWarning,
+		DisabledRules: []string{
+			LintRuleImageAltText,
+			LintRuleDeepNesting,
+			LintRuleMentionTextFormat,
+			LintRuleMentionWithoutEntity,
+		},
+	})
+
+	if len(findings) != 1 || findings[0].Rule != LintRuleUnwrappedLongText {
+		t.Errorf("got findings %v; want only %s", findings, LintRuleUnwrappedLongText)
+	}
+
+	if findings.HighestSeverity() != LintSeverityWarning {
+		t.Errorf("got highest severity %s; want %s", findings.HighestSeverity(), LintSeverityWarning)
+	}
+}
+
+func TestMessageLintClean(t *testing.T) {
+	heading := NewTextBlock("Service alert", true)
+	heading.Style = TextBlockStyleHeading
+
+	c := NewCard()
+	c.Body = []Element{heading, NewImage("https://example.com/status.png", "status")}
+
+	msg, err := NewMessageFromCard(c)
+	if err != nil {
+		t.Fatalf("unexpected error creating message: %v", err)
+	}
+
+	if findings := msg.Lint(nil); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}
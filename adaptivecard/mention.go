@@ -0,0 +1,227 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+)
+
+// NewTagMention uses the given tag name and tag ID to create a tag Mention
+// value for inclusion in a Card. All members of the tag are notified. An
+// error is returned if provided values are insufficient to create the
+// mention.
+func NewTagMention(tagName string, tagID string) (Mention, error) {
+	return newTypedMention(tagName, tagID, func(m *Mentioned) {
+		m.Type = MentionedTypeTag
+	})
+}
+
+// NewChannelMention uses the given channel name and channel conversation ID
+// (e.g., 19:abc123@thread.tacv2) to create a channel Mention value for
+// inclusion in a Card. An error is returned if provided values are
+// insufficient to create the mention.
+func NewChannelMention(channelName string, channelID string) (Mention, error) {
+	return newTypedMention(channelName, channelID, func(m *Mentioned) {
+		m.ConversationIdentityType = ConversationIdentityTypeChannel
+	})
+}
+
+// NewTeamMention uses the given team name and team conversation ID (e.g.,
+// 19:abc123@thread.tacv2) to create a team Mention value for inclusion in a
+// Card. An error is returned if provided values are insufficient to create
+// the mention.
+func NewTeamMention(teamName string, teamID string) (Mention, error) {
+	return newTypedMention(teamName, teamID, func(m *Mentioned) {
+		m.ConversationIdentityType = ConversationIdentityTypeTeam
+	})
+}
+
+// newTypedMention creates a Mention using the given name and ID and applies
+// the given function to set the type of the mentioned entity. An error is
+// returned if the Mention fails validation.
+func newTypedMention(name string, id string, setType func(*Mentioned)) (Mention, error) {
+	mention, err := NewMention(name, id)
+	if err != nil {
+		return Mention{}, err
+	}
+
+	setType(&mention.Mentioned)
+
+	if err := mention.Validate(); err != nil {
+		return Mention{}, err
+	}
+
+	return mention, nil
+}
+
+// ResolveMentions creates Mention values from the given config mapping of
+// names to mention references for the specified names. If no names are
+// specified, a Mention is created for every entry of the mapping in name
+// order.
+//
+// A mention reference consists of an optional prefix (e.g.,
+// MentionRefPrefixTag) followed by MentionRefSeparator and an ID; a
+// reference without a known prefix is a user ID. A leading "@" is removed
+// from names. For example:
+//
+//	"@sre-oncall": "tag:MjQzMmYy...",
+//	"General":     "channel:19:abc123@thread.tacv2",
+//	"Jane Doe":    "jane.doe@contoso.onmicrosoft.com",
+//
+// An error is returned if a name is not found or if a reference is invalid.
+func ResolveMentions(config map[string]string, names ...string) (Mentions, error) {
+	if len(names) == 0 {
+		names = make([]string, 0, len(config))
+		for name := range config {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+	}
+
+	mentions := make(Mentions, 0, len(names))
+
+	for _, name := range names {
+		ref, ok := config[name]
+		if !ok {
+			return nil, fmt.Errorf(
+				"mention %q not found in config: %w",
+				name,
+				ErrValueNotFound,
+			)
+		}
+
+		mention, err := resolveMention(strings.TrimPrefix(name, "@"), ref)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to resolve mention %q: %w",
+				name,
+				err,
+			)
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return mentions, nil
+}
+
+// resolveMention creates a Mention using the given name and mention
+// reference.
+func resolveMention(name string, ref string) (Mention, error) {
+	prefix, id := MentionRefPrefixUser, ref
+
+	if parts := strings.SplitN(ref, MentionRefSeparator, 2); len(parts) == 2 &&
+		goteamsnotify.InList(parts[0], supportedMentionRefPrefixValues(), false) {
+		prefix, id = parts[0], parts[1]
+	}
+
+	switch prefix {
+	case MentionRefPrefixTag:
+		return NewTagMention(name, id)
+	case MentionRefPrefixChannel:
+		return NewChannelMention(name, id)
+	case MentionRefPrefixTeam:
+		return NewTeamMention(name, id)
+	default:
+		return NewMention(name, id)
+	}
+}
+
+// assertValidConversationID asserts that the ID of a mentioned channel or
+// team matches ConversationIDRegex.
+func assertValidConversationID(m Mentioned) error {
+	if m.ConversationIdentityType == "" {
+		return nil
+	}
+
+	matched, _ := regexp.MatchString(ConversationIDRegex, m.ID)
+	if !matched {
+		return fmt.Errorf(
+			"invalid ID %q for mentioned %s; expected conversation ID (e.g., %q): %w",
+			m.ID,
+			m.ConversationIdentityType,
+			"19:abc123@thread.tacv2",
+			ErrInvalidFieldValue,
+		)
+	}
+
+	return nil
+}
+
+// elementTreeHasMentionText indicates whether the given Element or any
+// nested element contains the Mention text (see Element.HasMentionText).
+func elementTreeHasMentionText(e Element, m Mention) bool {
+	found := false
+
+	walkMentionElements(e, func(element Element) {
+		if !found && element.HasMentionText(m) {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// mentionTextPattern matches the mention text (see MentionTextRegex) within
+// the fields of an Element.
+var mentionTextPattern = regexp.MustCompile(MentionTextRegex)
+
+// unmatchedMentionTexts returns the mention texts (see MentionTextRegex)
+// found within the Text field or the facts of the given Element without a
+// matching mention. This text is displayed as-is and does not notify anyone.
+func unmatchedMentionTexts(e Element, mentions []Mention) []string {
+	mentionTexts := make([]string, 0, len(mentions))
+	for _, mention := range mentions {
+		mentionTexts = append(mentionTexts, mention.Text)
+	}
+
+	texts := []string{e.Text}
+	for _, fact := range e.Facts {
+		texts = append(texts, fact.Title, fact.Value)
+	}
+
+	var unmatched []string
+
+	for _, text := range texts {
+		for _, mentionText := range mentionTextPattern.FindAllString(text, -1) {
+			if !goteamsnotify.InList(mentionText, mentionTexts, false) {
+				unmatched = append(unmatched, mentionText)
+			}
+		}
+	}
+
+	return unmatched
+}
+
+// walkMentionElements calls fn for the given Element and every nested
+// element which may contain mention text (see Card.Walk). Only TextBlock and
+// FactSet elements support mentions; the Cards of Action.ShowCard actions are
+// skipped.
+func walkMentionElements(e Element, fn func(Element)) {
+	c := Card{Body: []Element{e}}
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		switch {
+		case node.Card != nil && node.Path != "":
+			return ErrSkipChildren
+
+		case node.Element != nil &&
+			(node.Element.Type == TypeElementTextBlock || node.Element.Type == TypeElementFactSet):
+			fn(*node.Element)
+		}
+
+		return nil
+	})
+}
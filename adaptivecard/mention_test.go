@@ -0,0 +1,193 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+)
+
+// TestTypedMentions asserts the entities generated for tag, channel and team
+// mentions. Tag mentions record the type of the mentioned entity, channel and
+// team mentions the conversation identity type. See:
+//
+//   - https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/conversations/channel-and-group-conversations#tag-mention
+//   - https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/conversations/channel-and-group-conversations#channel-and-team-mention
+func TestTypedMentions(t *testing.T) {
+	tag, err := NewTagMention("sre-oncall", "MjQzMmYyYzktOGJmYi00ZTQzLWI1ZjYtNWE3YjRjZTNhZmI5")
+	if err != nil {
+		t.Fatalf("unexpected error creating tag mention: %v", err)
+	}
+
+	channel, err := NewChannelMention("General", "19:abc123@thread.tacv2")
+	if err != nil {
+		t.Fatalf("unexpected error creating channel mention: %v", err)
+	}
+
+	team, err := NewTeamMention("SRE", "19:def456@thread.tacv2")
+	if err != nil {
+		t.Fatalf("unexpected error creating team mention: %v", err)
+	}
+
+	tests := []struct {
+		mention Mention
+		want    string
+	}{
+		{
+			mention: tag,
+			want: `{"type":"mention","text":"<at>sre-oncall</at>",` +
+				`"mentioned":{"id":"MjQzMmYyYzktOGJmYi00ZTQzLWI1ZjYtNWE3YjRjZTNhZmI5","name":"sre-oncall","type":"tag"}}`,
+		},
+		{
+			mention: channel,
+			want: `{"type":"mention","text":"<at>General</at>",` +
+				`"mentioned":{"id":"19:abc123@thread.tacv2","name":"General","conversationIdentityType":"channel"}}`,
+		},
+		{
+			mention: team,
+			want: `{"type":"mention","text":"<at>SRE</at>",` +
+				`"mentioned":{"id":"19:def456@thread.tacv2","name":"SRE","conversationIdentityType":"team"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		data, err := jsonenc.Marshal(tt.mention, false)
+		if err != nil {
+			t.Fatalf("unexpected error encoding mention: %v", err)
+		}
+
+		if string(data) != tt.want {
+			t.Errorf("got %s; want %s", data, tt.want)
+		}
+	}
+
+	if _, err := NewTeamMention("SRE", "not-a-conversation-id"); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+
+	// Only non-empty mention text is required, as in earlier releases; the
+	// format is reported by Message.Lint (see LintRuleMentionTextFormat).
+	unformatted := tag
+	unformatted.Text = "sre-oncall"
+	if err := unformatted.Validate(); err != nil {
+		t.Errorf("unexpected error validating mention: %v", err)
+	}
+
+	unformatted.Text = ""
+	if err := unformatted.Validate(); !errors.Is(err, ErrMissingValue) {
+		t.Errorf("got error %v; want %v", err, ErrMissingValue)
+	}
+}
+
+func TestResolveMentions(t *testing.T) {
+	config := map[string]string{
+		"@sre-oncall": "tag:MjQzMmYy",
+		"General":     "channel:19:abc123@thread.tacv2",
+		"Jane Doe":    "jane.doe@contoso.onmicrosoft.com",
+	}
+
+	mentions, err := ResolveMentions(config)
+	if err != nil {
+		t.Fatalf("unexpected error resolving mentions: %v", err)
+	}
+
+	want := []Mentioned{
+		{ID: "MjQzMmYy", Name: "sre-oncall", Type: MentionedTypeTag},
+		{ID: "19:abc123@thread.tacv2", Name: "General", ConversationIdentityType: ConversationIdentityTypeChannel},
+		{ID: "jane.doe@contoso.onmicrosoft.com", Name: "Jane Doe"},
+	}
+
+	if len(mentions) != len(want) {
+		t.Fatalf("got %d mentions; want %d", len(mentions), len(want))
+	}
+
+	for i := range want {
+		if mentions[i].Mentioned != want[i] {
+			t.Errorf("mention %d: got %+v; want %+v", i, mentions[i].Mentioned, want[i])
+		}
+	}
+
+	if _, err := ResolveMentions(config, "unknown"); !errors.Is(err, ErrValueNotFound) {
+		t.Errorf("got error %v; want %v", err, ErrValueNotFound)
+	}
+
+	if _, err := ResolveMentions(map[string]string{"General": "channel:General"}); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+}
+
+func TestCardMentionText(t *testing.T) {
+	mentions, err := ResolveMentions(map[string]string{"@sre-oncall": "tag:MjQzMmYy"})
+	if err != nil {
+		t.Fatalf("unexpected error resolving mentions: %v", err)
+	}
+
+	nested := func(text string) []Element {
+		container := Element(NewContainer())
+		container.Items = []Element{NewTextBlock(text, true)}
+
+		return []Element{container}
+	}
+
+	// Mentions are recorded by the top-level card only, including those for
+	// the mention text of the Cards of Action.ShowCard actions.
+	showCardBody := func(text string) []Element {
+		return []Element{NewTextBlock(text, true)}
+	}
+
+	tests := []struct {
+		name         string
+		body         []Element
+		showCardBody []Element
+		mentions     []Mention
+		wantErr      error
+	}{
+		{name: "nested mention text", body: nested("paging <at>sre-oncall</at>"), mentions: mentions},
+		{name: "showcard mention text", body: nested("paging <at>sre-oncall</at>"), showCardBody: showCardBody("paging <at>sre-oncall</at>"), mentions: mentions},
+		{name: "missing mention text", body: nested("paging the on-call team"), mentions: mentions, wantErr: ErrMissingValue},
+
+		// Mention text without a matching mention is reported by
+		// Message.Lint (see LintRuleMentionWithoutEntity).
+		{name: "showcard mention text without entity", body: nested("paging the on-call team"), showCardBody: showCardBody("paging <at>sre-oncall</at>")},
+		{name: "mention text without entity", body: nested("paging <at>sre-oncall</at>")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			card := NewCard()
+			card.Body = tt.body
+			card.MSTeams.Entities = tt.mentions
+
+			if tt.showCardBody != nil {
+				inner := NewCard()
+				inner.Body = tt.showCardBody
+
+				showCard, err := NewActionShowCard("details", inner)
+				if err != nil {
+					t.Fatalf("unexpected error creating showcard action: %v", err)
+				}
+				card.Actions = []Action{showCard}
+			}
+
+			err := card.Validate()
+
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != nil && !errors.Is(err, tt.wantErr):
+				t.Errorf("got error %v; want %v", err, tt.wantErr)
+			case err != nil && !strings.Contains(err.Error(), "mention"):
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,501 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+)
+
+// truncateSuffix is appended to text shortened by the truncate template
+// function.
+const truncateSuffix string = "…"
+
+// renderedLineMaxLength is the maximum number of characters of the offending
+// line of rendered output recorded by a TemplateError.
+const renderedLineMaxLength int = 80
+
+// templateErrorRegex matches the location prefix of errors returned by the
+// text/template package (e.g., "template: alert.json:3:12: executing ...").
+// The column is only reported for execution errors.
+var templateErrorRegex = regexp.MustCompile(`(?s)^template: (.*?):(\d+):(?:(\d+):)? ?(.*)$`)
+
+// yamlErrorLineRegex matches the line reported by errors returned by the
+// yaml package.
+var yamlErrorLineRegex = regexp.MustCompile(`line (\d+)`)
+
+// MessageTemplate is a Go text/template which renders a Message (or a
+// single Card) as JSON or YAML. This allows keeping cards in files with
+// placeholders for data supplied at runtime, e.g.:
+//
+//	{"type": "AdaptiveCard", "version": "1.4", "body": [
+//		{"type": "TextBlock", "text": "{{ truncate 80 .Title | jsonEscape }}"}
+//	]}
+//
+// In addition to the text/template builtins, the functions returned by
+// MessageTemplateFuncs are available. References to missing map keys are
+// reported as errors.
+type MessageTemplate struct {
+	tmpl *template.Template
+	yaml bool
+}
+
+// TemplateError describes an error parsing a MessageTemplate, rendering it
+// or decoding the rendered output. TemplateError wraps ErrTemplateExpansion.
+type TemplateError struct {
+	// Name is the name of the template (e.g., the file name).
+	Name string
+
+	// Line is the line of the error, starting at 1, or 0 if unknown.
+	Line int
+
+	// Column is the column of the error, starting at 1, or 0 if unknown.
+	// The text/template package does not report columns for syntax errors.
+	Column int
+
+	// Rendered indicates that Line and Column refer to the rendered output
+	// rather than the template (e.g., for invalid JSON produced by the
+	// template). Locations within the rendered output are not mapped back to
+	// the template, and the column is not reported for YAML output.
+	Rendered bool
+
+	// RenderedLine is the line of the rendered output referred to by Line
+	// if Rendered is true. Long lines are shortened to the characters
+	// around Column, marking truncation with an ellipsis.
+	RenderedLine string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the location and description of the error.
+func (e *TemplateError) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.Name)
+
+	if e.Rendered {
+		b.WriteString(" (rendered output)")
+	}
+
+	if e.Line > 0 {
+		fmt.Fprintf(&b, ":%d", e.Line)
+
+		if e.Column > 0 {
+			fmt.Fprintf(&b, ":%d", e.Column)
+		}
+	}
+
+	fmt.Fprintf(&b, ": %v", e.Err)
+
+	if e.RenderedLine != "" {
+		fmt.Fprintf(&b, " (in %q)", e.RenderedLine)
+	}
+
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// MessageTemplateFuncs returns the helper functions available to a
+// MessageTemplate:
+//
+//   - json: the JSON encoding of a value (e.g., a quoted and escaped string)
+//   - jsonEscape: a string escaped for use within a JSON (or double quoted
+//     YAML) string
+//   - mention: the JSON encoding of a user Mention entity for the given
+//     display name and ID (see NewMention)
+//   - mentionText: the text referencing a user Mention with the given
+//     display name, escaped as by jsonEscape
+//   - formatTime: a time.Time, RFC 3339 string or Unix time value formatted
+//     using the given layout (e.g., "2006-01-02 15:04 MST"); an empty
+//     layout selects time.RFC3339
+//   - truncate: a string shortened to at most the given number of
+//     characters, marking truncation with an ellipsis
+func MessageTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"json":        templateFuncJSON,
+		"jsonEscape":  templateFuncJSONEscape,
+		"mention":     templateFuncMention,
+		"mentionText": templateFuncMentionText,
+		"formatTime":  templateFuncFormatTime,
+		"truncate":    templateFuncTruncate,
+	}
+}
+
+// ParseMessageTemplate parses the given template text. The name identifies
+// the template in errors. Templates whose name has a ".yaml" or ".yml"
+// extension render YAML, all others render JSON.
+func ParseMessageTemplate(name string, text string) (*MessageTemplate, error) {
+	tmpl, err := template.New(name).
+		Funcs(MessageTemplateFuncs()).
+		Option("missingkey=error").
+		Parse(text)
+	if err != nil {
+		return nil, newTemplateError(name, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return &MessageTemplate{tmpl: tmpl, yaml: true}, nil
+	default:
+		return &MessageTemplate{tmpl: tmpl}, nil
+	}
+}
+
+// LoadMessageTemplate reads and parses the template file with the given
+// name (see ParseMessageTemplate).
+func LoadMessageTemplate(filename string) (*MessageTemplate, error) {
+	text, err := ioutil.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return nil, fmt.Errorf("error reading template file: %w", err)
+	}
+
+	return ParseMessageTemplate(filepath.Base(filename), string(text))
+}
+
+// RenderMessageTemplateFile loads the given template file and renders it
+// using the given data (see LoadMessageTemplate and
+// MessageTemplate.Render).
+func RenderMessageTemplateFile(filename string, data interface{}) (*Message, error) {
+	t, err := LoadMessageTemplate(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Render(data)
+}
+
+// Render renders the template using the given data (e.g., a
+// map[string]interface{} value) and decodes the output into a Message. If
+// the output is a single Adaptive Card, the Card is attached to a new
+// Message.
+//
+// The output is decoded using ParseMessage or ParseCard; properties unknown
+// to this package are preserved.
+//
+// A *TemplateError is returned if the template cannot be rendered or the
+// output cannot be decoded. If validation of the Message fails, the Message
+// is returned along with the validation error (see ParseMessage).
+//
+// Errors decoding the output refer to the rendered output rather than the
+// template, as template actions may produce any number of lines. For YAML
+// templates only the line is reported, and errors decoding the converted
+// document (e.g., a string given for a list property) are reported without
+// a location.
+func (t *MessageTemplate) Render(data interface{}) (*Message, error) {
+	var buf bytes.Buffer
+
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return nil, newTemplateError(t.tmpl.Name(), err)
+	}
+
+	payload := buf.Bytes()
+
+	if t.yaml {
+		converted, err := yamlToJSON(payload)
+		if err != nil {
+			return nil, t.outputError(payload, err)
+		}
+
+		payload = converted
+	}
+
+	var header struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal(payload, &header); err != nil {
+		return nil, t.outputError(payload, err)
+	}
+
+	if header.Type != TypeAdaptiveCard {
+		msg, err := ParseMessage(payload)
+		if msg == nil {
+			return nil, t.outputError(payload, err)
+		}
+
+		return msg, err
+	}
+
+	// A decoded card has the type checked above; the zero value is returned
+	// only if decoding fails.
+	card, err := ParseCard(payload)
+	if card.Type == "" {
+		return nil, t.outputError(payload, err)
+	}
+
+	msg, msgErr := NewMessageFromCard(card)
+	if msgErr != nil {
+		return nil, msgErr
+	}
+
+	if err != nil {
+		return msg, err
+	}
+
+	if err := msg.Validate(); err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
+
+// newTemplateError returns a *TemplateError for the given error returned by
+// the text/template package.
+func newTemplateError(name string, err error) error {
+	templateErr := TemplateError{Name: name}
+
+	msg := err.Error()
+
+	var execErr template.ExecError
+	if errors.As(err, &execErr) {
+		msg = execErr.Err.Error()
+	}
+
+	if m := templateErrorRegex.FindStringSubmatch(msg); m != nil {
+		templateErr.Line, _ = strconv.Atoi(m[2])
+		msg = m[4]
+
+		// The text/template package reports zero-based columns.
+		if m[3] != "" {
+			column, _ := strconv.Atoi(m[3])
+			templateErr.Column = column + 1
+		}
+	}
+
+	templateErr.Err = fmt.Errorf("%s: %w", msg, ErrTemplateExpansion)
+
+	return &templateErr
+}
+
+// outputError returns a *TemplateError for the given error decoding the
+// rendered output of the template. The location is derived from JSON syntax
+// errors and YAML errors.
+func (t *MessageTemplate) outputError(output []byte, err error) error {
+	templateErr := TemplateError{
+		Name:     t.tmpl.Name(),
+		Rendered: true,
+		Err:      fmt.Errorf("error decoding rendered output: %v: %w", err, ErrTemplateExpansion),
+	}
+
+	var syntaxErr *json.SyntaxError
+
+	switch {
+	case t.yaml:
+		if m := yamlErrorLineRegex.FindStringSubmatch(err.Error()); m != nil {
+			templateErr.Line, _ = strconv.Atoi(m[1])
+		}
+
+	case errors.As(err, &syntaxErr):
+		// The offset follows the offending byte.
+		templateErr.Line, templateErr.Column = textPosition(output, syntaxErr.Offset-1)
+	}
+
+	if templateErr.Line > 0 {
+		lines := strings.Split(string(output), "\n")
+		if templateErr.Line <= len(lines) {
+			templateErr.RenderedLine = lineExcerpt(lines[templateErr.Line-1], templateErr.Column)
+		}
+	}
+
+	return &templateErr
+}
+
+// lineExcerpt returns the given line with surrounding whitespace removed,
+// shortened to at most renderedLineMaxLength characters around the given
+// column (starting at 1, or 0 if unknown).
+func lineExcerpt(line string, column int) string {
+	text := strings.TrimRight(line, " \t\r")
+	trimmed := strings.TrimLeft(text, " \t")
+
+	// Keep the column relative to the trimmed text.
+	column -= utf8.RuneCountInString(text) - utf8.RuneCountInString(trimmed)
+	runes := []rune(trimmed)
+
+	if len(runes) <= renderedLineMaxLength {
+		return string(runes)
+	}
+
+	start := 0
+	if column > renderedLineMaxLength/2 {
+		start = column - renderedLineMaxLength/2
+	}
+
+	if start > len(runes)-renderedLineMaxLength {
+		start = len(runes) - renderedLineMaxLength
+	}
+
+	excerpt := string(runes[start : start+renderedLineMaxLength])
+
+	if start > 0 {
+		excerpt = truncateSuffix + excerpt
+	}
+
+	if start+renderedLineMaxLength < len(runes) {
+		excerpt += truncateSuffix
+	}
+
+	return excerpt
+}
+
+// textPosition returns the line and column, both starting at 1, of the given
+// byte offset within text.
+func textPosition(text []byte, offset int64) (int, int) {
+	if offset > int64(len(text)) {
+		offset = int64(len(text))
+	}
+
+	before := text[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := utf8.RuneCount(before[bytes.LastIndexByte(before, '\n')+1:]) + 1
+
+	return line, column
+}
+
+// yamlToJSON converts the given YAML document to JSON. Unquoted numeric
+// "version" values (e.g., version: 1.4) are converted to strings as written.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	quoteYAMLVersions(&doc)
+
+	var v interface{}
+	if err := doc.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return jsonenc.Marshal(v, false)
+}
+
+// quoteYAMLVersions marks the numeric values of "version" mapping keys
+// within the given YAML node as strings. The text of the values is retained
+// (e.g., "1.0" rather than "1").
+func quoteYAMLVersions(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.Value == "version" && value.Kind == yaml.ScalarNode &&
+				(value.Tag == "!!float" || value.Tag == "!!int") {
+				value.Tag = "!!str"
+			}
+		}
+	}
+
+	for _, child := range node.Content {
+		quoteYAMLVersions(child)
+	}
+}
+
+func templateFuncJSON(v interface{}) (string, error) {
+	data, err := jsonenc.Marshal(v, false)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func templateFuncJSONEscape(s string) (string, error) {
+	quoted, err := templateFuncJSON(s)
+	if err != nil {
+		return "", err
+	}
+
+	return quoted[1 : len(quoted)-1], nil
+}
+
+func templateFuncMention(displayName string, id string) (string, error) {
+	mention, err := NewMention(displayName, id)
+	if err != nil {
+		return "", err
+	}
+
+	return templateFuncJSON(mention)
+}
+
+func templateFuncMentionText(displayName string) (string, error) {
+	return templateFuncJSONEscape(fmt.Sprintf(MentionTextFormatTemplate, displayName))
+}
+
+func templateFuncFormatTime(layout string, v interface{}) (string, error) {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	var t time.Time
+
+	switch v := v.(type) {
+	case time.Time:
+		t = v
+
+	case *time.Time:
+		if v == nil {
+			return "", fmt.Errorf("nil time value: %w", ErrMissingValue)
+		}
+
+		t = *v
+
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return "", fmt.Errorf("invalid timestamp %q: %w", v, ErrInvalidFieldValue)
+		}
+
+		t = parsed
+
+	case int:
+		t = time.Unix(int64(v), 0)
+
+	case int64:
+		t = time.Unix(v, 0)
+
+	case float64:
+		t = time.Unix(0, int64(v*float64(time.Second)))
+
+	default:
+		return "", fmt.Errorf("unsupported time value type %T: %w", v, ErrInvalidType)
+	}
+
+	return t.Format(layout), nil
+}
+
+func templateFuncTruncate(length int, s string) string {
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+
+	if length <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+
+	return string(runes[:length-1]) + truncateSuffix
+}
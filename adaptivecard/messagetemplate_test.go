@@ -0,0 +1,240 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const messageTemplateTestJSON = `{
+	"type": "AdaptiveCard",
+	"version": "1.4",
+	"body": [
+		{"type": "TextBlock", "text": "{{ truncate 12 .title | jsonEscape }}", "wrap": true},
+		{"type": "TextBlock", "text": "{{ mentionText .owner }} checked at {{ formatTime "15:04" .checked }}"}
+	],
+	"msteams": {"entities": [{{ mention .owner .ownerID }}]}
+}`
+
+const messageTemplateTestYAML = `type: message
+attachments:
+  - contentType: application/vnd.microsoft.card.adaptive
+    content:
+      type: AdaptiveCard
+      version: "1.4"
+      body:
+{{- range .hosts }}
+        - type: TextBlock
+          text: {{ json . }}
+{{- end }}
+`
+
+func TestMessageTemplateRender(t *testing.T) {
+	dir, err := ioutil.TempDir("", "messagetemplate")
+	if err != nil {
+		t.Fatalf("unexpected error creating directory: %v", err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "alert.json")
+	if err := ioutil.WriteFile(filename, []byte(messageTemplateTestJSON), 0600); err != nil {
+		t.Fatalf("unexpected error writing template: %v", err)
+	}
+
+	msg, err := RenderMessageTemplateFile(filename, map[string]interface{}{
+		"title":   `Disk "/var" full on host01`,
+		"owner":   "Jane Doe",
+		"ownerID": "jane.doe@example.com",
+		"checked": time.Date(2026, 10, 18, 7, 5, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	card := msg.Attachments[0].Content
+
+	if got, want := card.Body[0].Text, `Disk "/var"…`; got != want {
+		t.Errorf("got text %q; want %q", got, want)
+	}
+
+	if got, want := card.Body[1].Text, "<at>Jane Doe</at> checked at 07:05"; got != want {
+		t.Errorf("got text %q; want %q", got, want)
+	}
+
+	if len(card.MSTeams.Entities) != 1 || card.MSTeams.Entities[0].Mentioned.ID != "jane.doe@example.com" {
+		t.Errorf("got entities %+v; want mention of jane.doe@example.com", card.MSTeams.Entities)
+	}
+
+	tmpl, err := ParseMessageTemplate("hosts.yaml", messageTemplateTestYAML)
+	if err != nil {
+		t.Fatalf("unexpected error parsing YAML template: %v", err)
+	}
+
+	msg, err = tmpl.Render(map[string]interface{}{"hosts": []string{"host01: down", "host02"}})
+	if err != nil {
+		t.Fatalf("unexpected error rendering YAML template: %v", err)
+	}
+
+	body := msg.Attachments[0].Content.Body
+	if len(body) != 2 || body[0].Text != "host01: down" || body[1].Text != "host02" {
+		t.Errorf("got body %+v; want TextBlocks for host01 and host02", body)
+	}
+}
+
+func TestMessageTemplateRenderYAMLNumericVersion(t *testing.T) {
+	for _, version := range []string{"1.4", "1.0"} {
+		text := "type: AdaptiveCard\nversion: " + version + "\nbody:\n  - type: TextBlock\n    text: {{ .text }}\n"
+
+		tmpl, err := ParseMessageTemplate("card.yaml", text)
+		if err != nil {
+			t.Fatalf("unexpected error parsing template: %v", err)
+		}
+
+		msg, err := tmpl.Render(map[string]interface{}{"text": "summary"})
+		if err != nil {
+			t.Fatalf("version %s: unexpected error: %v", version, err)
+		}
+
+		if got := msg.Attachments[0].Content.Version; got != version {
+			t.Errorf("got version %q; want %q", got, version)
+		}
+	}
+}
+
+func TestMessageTemplateRenderUnknownFields(t *testing.T) {
+	text := `{"type": "AdaptiveCard", "version": "1.4", "custom": {{ .custom }}, "body": [
+		{"type": "TextBlock", "text": "summary", "future": {"enabled": true}}
+	]}`
+
+	tmpl, err := ParseMessageTemplate("card.json", text)
+	if err != nil {
+		t.Fatalf("unexpected error parsing template: %v", err)
+	}
+
+	msg, err := tmpl.Render(map[string]interface{}{"custom": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	card := msg.Attachments[0].Content
+
+	if got, want := string(card.UnknownFields["custom"]), "true"; got != want {
+		t.Errorf("got card property custom %q; want %q", got, want)
+	}
+
+	if got, want := string(card.Body[0].UnknownFields["future"]), `{"enabled": true}`; got != want {
+		t.Errorf("got element property future %q; want %q", got, want)
+	}
+}
+
+func TestMessageTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		filename   string
+		text       string
+		data       interface{}
+		wantLine   int
+		wantColumn int
+		rendered   bool
+		wantText   string
+	}{
+		{
+			name:     "syntax error",
+			filename: "alert.json",
+			text:     "{\n\"type\": \"{{ .type \"}\n}",
+			wantLine: 2,
+		},
+		{
+			name:       "missing key",
+			filename:   "alert.json",
+			text:       "{\n  \"type\": \"{{ .type }}\"\n}",
+			data:       map[string]interface{}{},
+			wantLine:   2,
+			wantColumn: 15,
+		},
+		{
+			name:       "function error",
+			filename:   "alert.json",
+			text:       `{"msteams": {"entities": [{{ mention "" "id" }}]}}`,
+			wantLine:   1,
+			wantColumn: 30,
+		},
+		{
+			name:       "invalid JSON output",
+			filename:   "alert.json",
+			text:       "{\n  \"type\": {{ .type }}\n}",
+			data:       map[string]interface{}{"type": "message"},
+			wantLine:   2,
+			wantColumn: 11,
+			rendered:   true,
+			wantText:   `"type": message`,
+		},
+		{
+			name:       "invalid JSON output on long line",
+			filename:   "alert.json",
+			text:       `{"type": "message", "summary": "{{ .summary }}", "attachments": {{ .attachments }}}`,
+			data:       map[string]interface{}{"summary": strings.Repeat("x", 100), "attachments": "none"},
+			wantLine:   1,
+			wantColumn: 152,
+			rendered:   true,
+			wantText:   "…" + strings.Repeat("x", 57) + `", "attachments": none}`,
+		},
+		{
+			name:     "invalid YAML output",
+			filename: "alert.yml",
+			text:     "type: message\nattachments: [\n",
+			wantLine: 2,
+			rendered: true,
+			wantText: "attachments: [",
+		},
+		{
+			name:     "invalid YAML value",
+			filename: "alert.yml",
+			text:     "type: message\nattachments: none\n",
+			rendered: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseMessageTemplate(tt.filename, tt.text)
+			if err == nil {
+				_, err = tmpl.Render(tt.data)
+			}
+
+			var templateErr *TemplateError
+			if !errors.As(err, &templateErr) || !errors.Is(err, ErrTemplateExpansion) {
+				t.Fatalf("got error %v; want TemplateError wrapping %v", err, ErrTemplateExpansion)
+			}
+
+			if templateErr.Line != tt.wantLine || templateErr.Column != tt.wantColumn ||
+				templateErr.Rendered != tt.rendered {
+				t.Errorf("got line %d, column %d, rendered %t; want %d, %d, %t (error: %v)",
+					templateErr.Line, templateErr.Column, templateErr.Rendered,
+					tt.wantLine, tt.wantColumn, tt.rendered, err)
+			}
+
+			if templateErr.RenderedLine != tt.wantText {
+				t.Errorf("got rendered line %q; want %q", templateErr.RenderedLine, tt.wantText)
+			}
+
+			if tt.wantText != "" && !strings.Contains(err.Error(), strconv.Quote(tt.wantText)) {
+				t.Errorf("error %q does not include rendered line %q", err, tt.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,716 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+)
+
+// ParseMessage decodes the given JSON Message payload (e.g., exported from
+// a card designer or received from another service) and validates the
+// result (see Message.Validate).
+//
+// Properties unknown to this package are preserved in the UnknownFields
+// field of messages, attachments, cards, elements, columns, table rows and
+// cells, text runs, actions and the msteams property; encoding the Message
+// produces JSON which is semantically equal to the given payload.
+//
+// An error is returned if the payload cannot be decoded. If validation
+// fails, the decoded Message is returned along with the validation error to
+// allow the Message to be corrected.
+func ParseMessage(data []byte) (*Message, error) {
+	var m Message
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("error decoding Message: %w", err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return &m, err
+	}
+
+	return &m, nil
+}
+
+// ParseCard decodes the given JSON Adaptive Card and validates the result
+// as a top-level card (see TopLevelCard.Validate). Properties unknown to
+// this package are preserved (see ParseMessage).
+//
+// An error is returned if the card cannot be decoded. If validation fails,
+// the decoded Card is returned along with the validation error to allow the
+// Card to be corrected.
+func ParseCard(data []byte) (Card, error) {
+	var c Card
+	if err := json.Unmarshal(data, &c); err != nil {
+		return Card{}, fmt.Errorf("error decoding Card: %w", err)
+	}
+
+	if err := (TopLevelCard{Card: c}).Validate(); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. UnknownFields of the
+// Message are included; attachments are encoded by their own MarshalJSON
+// method.
+func (m Message) MarshalJSON() ([]byte, error) {
+	return (&m).marshalJSON(false)
+}
+
+// marshalJSON returns the JSON encoding of the Message, optionally escaping
+// the characters <, > and &. The Message is encoded in a single pass unless
+// it has UnknownFields.
+func (m *Message) marshalJSON(escapeHTML bool) ([]byte, error) {
+	// Use an alias type to prevent infinite recursion.
+	type message Message
+
+	var extras []jsonExtras
+
+	if len(m.UnknownFields) > 0 {
+		extras = append(extras, jsonExtras{Fields: m.UnknownFields})
+	}
+
+	// A pointer is encoded to avoid copying the value.
+	return marshalWithExtras((*message)(m), extras, escapeHTML)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type message Message
+
+	var decoded message
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*m = Message(decoded)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. UnknownFields are
+// included.
+func (a Attachment) MarshalJSON() ([]byte, error) {
+	// Use an alias type to prevent infinite recursion.
+	type attachment Attachment
+
+	var extras []jsonExtras
+
+	if len(a.UnknownFields) > 0 {
+		extras = append(extras, jsonExtras{Fields: a.UnknownFields})
+	}
+
+	// HTML escaping, if enabled, is applied by the caller's encoder.
+	return marshalWithExtras((*attachment)(&a), extras, false)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type attachment Attachment
+
+	var decoded attachment
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*a = Attachment(decoded)
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. UnknownFields of the
+// Card tree are included and an empty MSTeams value is omitted. Actions and
+// the Cards of Action.ShowCard actions are encoded by their own MarshalJSON
+// method.
+func (c Card) MarshalJSON() ([]byte, error) {
+	// Use an alias type to prevent infinite recursion.
+	type card Card
+
+	// The MSTeams field of the embedded value is shadowed.
+	aux := struct {
+		card
+		MSTeams *MSTeams `json:"msteams,omitempty"`
+	}{
+		card: card(c),
+	}
+
+	// References to the copy held by aux are used so that only aux is
+	// allocated.
+	if !aux.card.MSTeams.isEmpty() {
+		aux.MSTeams = &aux.card.MSTeams
+	}
+
+	extras := (*Card)(&aux.card).jsonExtras()
+
+	// A pointer is encoded to avoid copying the value.
+	return marshalWithExtras(&aux, extras, false)
+}
+
+// jsonExtras returns the properties of the Card tree which are not encoded
+// by the plain struct encoding of the Card: UnknownFields of the Card,
+// elements, columns, table rows and cells and text runs. The Cards of
+// Action.ShowCard actions are skipped.
+//
+// The tree is first inspected without computing node paths, so that Cards
+// without such properties are encoded without additional allocations.
+func (c *Card) jsonExtras() []jsonExtras {
+	found := false
+
+	_ = c.walkNodes(func(node Node) error {
+		if node.Card != nil && node.Card != c {
+			return ErrSkipChildren
+		}
+
+		nodeJSONExtras(node, func(jsonExtras) { found = true })
+
+		if found {
+			return errStopWalk
+		}
+
+		return nil
+	})
+
+	if !found {
+		return nil
+	}
+
+	var extras []jsonExtras
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		if node.Card != nil && node.Card != c {
+			return ErrSkipChildren
+		}
+
+		nodeJSONExtras(node, func(extra jsonExtras) { extras = append(extras, extra) })
+
+		return nil
+	})
+
+	return extras
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (c *Card) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type card Card
+
+	var decoded card
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*c = Card(decoded)
+
+	return nil
+}
+
+// isEmpty indicates whether all fields of the MSTeams value are unset.
+func (m MSTeams) isEmpty() bool {
+	return m.Width == "" &&
+		!m.AllowExpand &&
+		len(m.Entities) == 0 &&
+		len(m.UnknownFields) == 0
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (m *MSTeams) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type msTeams MSTeams
+
+	var decoded msTeams
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*m = MSTeams(decoded)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (e *Element) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type element Element
+
+	var decoded element
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*e = Element(decoded)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields. A numeric Width without
+// fractional part is decoded as an int value as used by the Column helper
+// functions.
+func (c *Column) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type column Column
+
+	var decoded column
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	if width, ok := decoded.Width.(float64); ok && width == math.Trunc(width) &&
+		width >= math.MinInt32 && width <= math.MaxInt32 {
+		decoded.Width = int(width)
+	}
+
+	*c = Column(decoded)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (tr *TableRow) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type tableRow TableRow
+
+	var decoded tableRow
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*tr = TableRow(decoded)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Unknown
+// properties are recorded in UnknownFields.
+func (tc *TableCell) UnmarshalJSON(data []byte) error {
+	// Use an alias type to prevent infinite recursion.
+	type tableCell TableCell
+
+	var decoded tableCell
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*tc = TableCell(decoded)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Both the element
+// ID string form and the TargetElement object form of targetElements entries
+// are supported. Entries given as an element ID are encoded in the
+// equivalent object form.
+func (te *TargetElement) UnmarshalJSON(data []byte) error {
+	var elementID string
+	if err := json.Unmarshal(data, &elementID); err == nil {
+		*te = TargetElement{ElementID: elementID}
+
+		return nil
+	}
+
+	// Use an alias type to prevent infinite recursion.
+	type targetElement TargetElement
+
+	var decoded targetElement
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*te = TargetElement(decoded)
+
+	return nil
+}
+
+// jsonExtras are properties added to the JSON object at Path of an encoded
+// value (see marshalWithExtras).
+type jsonExtras struct {
+	// Path is the JSON Pointer (RFC 6901) location of the object; the root
+	// object is located at the empty path.
+	Path string
+
+	// Fields are the unknown properties added to the object.
+	Fields map[string]json.RawMessage
+
+	// Fallback, if not nil, is encoded as the fallback property of the
+	// object.
+	Fallback interface{}
+}
+
+// nodeJSONExtras calls add for every property of the given Card tree node
+// which is not encoded by the plain struct encoding of the node. The
+// properties of table rows, cells and column definitions are reported for
+// the Table element. Paths are only computed if add is called. Actions encode
+// their own UnknownFields and fallback actions (see Action.MarshalJSON).
+func nodeJSONExtras(node Node, add func(jsonExtras)) {
+	switch {
+	case node.Card != nil:
+		if len(node.Card.UnknownFields) > 0 {
+			add(jsonExtras{Path: node.Path, Fields: node.Card.UnknownFields})
+		}
+
+		if len(node.Card.MSTeams.UnknownFields) > 0 {
+			add(jsonExtras{Path: node.Path + "/msteams", Fields: node.Card.MSTeams.UnknownFields})
+		}
+
+	case node.Element != nil:
+		e := node.Element
+
+		if len(e.UnknownFields) > 0 {
+			add(jsonExtras{Path: node.Path, Fields: e.UnknownFields})
+		}
+
+		// The columns of a ColumnSet are visited as nodes of their own.
+		if e.Type == TypeElementTable {
+			for i, column := range e.Columns {
+				if len(column.UnknownFields) > 0 {
+					add(jsonExtras{
+						Path:   fmt.Sprintf("%s/columns/%d", node.Path, i),
+						Fields: column.UnknownFields,
+					})
+				}
+			}
+		}
+
+		for i, row := range e.Rows {
+			if len(row.UnknownFields) > 0 {
+				add(jsonExtras{
+					Path:   fmt.Sprintf("%s/rows/%d", node.Path, i),
+					Fields: row.UnknownFields,
+				})
+			}
+
+			for j, cell := range row.Cells {
+				if len(cell.UnknownFields) > 0 {
+					add(jsonExtras{
+						Path:   fmt.Sprintf("%s/rows/%d/cells/%d", node.Path, i, j),
+						Fields: cell.UnknownFields,
+					})
+				}
+			}
+		}
+
+	case node.Column != nil:
+		if len(node.Column.UnknownFields) > 0 {
+			add(jsonExtras{Path: node.Path, Fields: node.Column.UnknownFields})
+		}
+
+	case node.TextRun != nil:
+		if len(node.TextRun.UnknownFields) > 0 {
+			add(jsonExtras{Path: node.Path, Fields: node.TextRun.UnknownFields})
+		}
+
+	}
+}
+
+// marshalWithExtras returns the JSON encoding of the given value, optionally
+// escaping the characters <, > and &. If extras are given, the encoded value
+// is decoded once, the extra properties are appended in the given order and
+// the result is encoded again; properties which are also encoded for the
+// value are kept as-is. Otherwise the value is encoded in a single pass.
+func marshalWithExtras(v interface{}, extras []jsonExtras, escapeHTML bool) ([]byte, error) {
+	data, err := jsonenc.Marshal(v, escapeHTML)
+	if err != nil || len(extras) == 0 {
+		return data, err
+	}
+
+	tree, err := decodeJSONTree(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extra := range extras {
+		object, ok := jsonPointerObject(tree, extra.Path)
+		if !ok {
+			return nil, fmt.Errorf("no JSON object found at %q for additional properties", extra.Path)
+		}
+
+		names := make([]string, 0, len(extra.Fields))
+		for name := range extra.Fields {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			value, err := decodeJSONTree(extra.Fields[name])
+			if err != nil {
+				return nil, err
+			}
+
+			object.add(name, value)
+		}
+
+		if extra.Fallback == nil {
+			continue
+		}
+
+		fallback, err := jsonenc.Marshal(extra.Fallback, false)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decodeJSONTree(fallback)
+		if err != nil {
+			return nil, err
+		}
+
+		object.add("fallback", value)
+	}
+
+	return jsonenc.Marshal(tree, escapeHTML)
+}
+
+// jsonObject is a decoded JSON object which retains the order of its
+// properties when encoded.
+type jsonObject struct {
+	names  []string
+	values map[string]interface{}
+}
+
+// add appends the given property unless the object already has a property
+// of the same name.
+func (o *jsonObject) add(name string, value interface{}) {
+	if _, ok := o.values[name]; ok {
+		return
+	}
+
+	o.names = append(o.names, name)
+	o.values[name] = value
+}
+
+// MarshalJSON implements the json.Marshaler interface. Properties are
+// encoded in order.
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+
+	for i, name := range o.names {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		// Properties are appended to buf directly. HTML escaping, if
+		// enabled, is applied by the caller's encoder.
+		var err error
+
+		buf, err = jsonenc.Append(buf, name, false)
+		if err != nil {
+			return nil, err
+		}
+
+		buf = append(buf, ':')
+
+		buf, err = jsonenc.Append(buf, o.values[name], false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return append(buf, '}'), nil
+}
+
+// decodeJSONTree decodes the given JSON value into jsonObject values, slices
+// and scalar values. Numbers are decoded as json.Number values to encode them
+// as-is.
+func decodeJSONTree(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return decodeJSONTreeValue(dec)
+}
+
+// decodeJSONTreeValue decodes the next JSON value of the given Decoder (see
+// decodeJSONTree).
+func decodeJSONTreeValue(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		object := &jsonObject{values: make(map[string]interface{})}
+
+		for dec.More() {
+			name, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := decodeJSONTreeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			object.add(name.(string), value)
+		}
+
+		// Consume the closing delimiter.
+		_, err := dec.Token()
+
+		return object, err
+
+	case json.Delim('['):
+		array := []interface{}{}
+
+		for dec.More() {
+			value, err := decodeJSONTreeValue(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, value)
+		}
+
+		// Consume the closing delimiter.
+		_, err := dec.Token()
+
+		return array, err
+	}
+
+	return token, nil
+}
+
+// jsonPointerObject returns the JSON object located at the given JSON
+// Pointer within a tree decoded by decodeJSONTree.
+func jsonPointerObject(tree interface{}, pointer string) (*jsonObject, bool) {
+	node := tree
+
+	if pointer != "" {
+		for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+			switch value := node.(type) {
+			case *jsonObject:
+				node = value.values[token]
+
+			case []interface{}:
+				i, err := strconv.Atoi(token)
+				if err != nil || i < 0 || i >= len(value) {
+					return nil, false
+				}
+
+				node = value[i]
+
+			default:
+				return nil, false
+			}
+		}
+	}
+
+	object, ok := node.(*jsonObject)
+
+	return object, ok
+}
+
+// unmarshalWithUnknownFields decodes the given JSON object into the struct
+// value v and records properties which do not match a field of the struct
+// in unknown (see unknownJSONFields).
+func unmarshalWithUnknownFields(data []byte, v interface{}, unknown *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	fields, err := unknownJSONFields(data, reflect.TypeOf(v).Elem())
+	if err != nil {
+		return err
+	}
+
+	*unknown = fields
+
+	return nil
+}
+
+// unknownJSONFields returns the properties of the given JSON object which do
+// not match a field of the given struct type or nil if all properties match.
+// As with json.Unmarshal, properties are matched to fields case
+// insensitively.
+func unknownJSONFields(data []byte, t reflect.Type) (map[string]json.RawMessage, error) {
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+
+	known := jsonFieldNames(t)
+
+	for name := range properties {
+		if known[strings.ToLower(name)] {
+			delete(properties, name)
+		}
+	}
+
+	if len(properties) == 0 {
+		return nil, nil
+	}
+
+	return properties, nil
+}
+
+// jsonFieldNameCache holds the lowercase JSON property names of struct
+// types, keyed by type.
+var jsonFieldNameCache sync.Map
+
+// jsonFieldNames returns the lowercase JSON property names of the fields of
+// the given struct type, including fields of embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	if names, ok := jsonFieldNameCache.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := make(map[string]bool)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		if name == "" && field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for embedded := range jsonFieldNames(field.Type) {
+				names[embedded] = true
+			}
+
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		names[strings.ToLower(name)] = true
+	}
+
+	jsonFieldNameCache.Store(t, names)
+
+	return names
+}
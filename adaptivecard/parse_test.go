@@ -0,0 +1,249 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const parseTestMessage = `{
+	"type": "message",
+	"summary": "Service alert",
+	"attachments": [{
+		"contentType": "application/vnd.microsoft.card.adaptive",
+		"content": {
+			"type": "AdaptiveCard",
+			"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+			"version": "1.4",
+			"designerVersion": "2.3.0",
+			"msteams": {"width": "Full", "theme": "dark"},
+			"body": [
+				{"type": "TextBlock", "id": "title", "text": "Service alert", "wrap": true, "targetWidth": "atLeast:narrow"},
+				{"type": "ColumnSet", "columns": [
+					{"type": "Column", "width": 2, "items": [{"type": "TextBlock", "text": "host01"}], "pixelMinHeight": 20},
+					{"type": "Column", "width": "auto", "items": [{"type": "RichTextBlock", "inlines": [
+						{"type": "TextRun", "text": "DOWN", "color": "attention", "lang": "en"}
+					]}]}
+				]},
+				{"type": "Table", "columns": [{"type": "TableColumnDefinition", "width": 1}], "rows": [
+					{"type": "TableRow", "cells": [
+						{"type": "TableCell", "items": [{"type": "TextBlock", "text": "cell"}], "rtl": false, "custom": 1}
+					], "custom": true}
+				]}
+			],
+			"actions": [
+				{"type": "Action.OpenUrl", "title": "Runbook", "url": "https://example.com/runbook", "mode": "secondary",
+					"fallback": {"type": "Action.OpenUrl", "title": "Wiki", "url": "https://example.com/wiki", "mode": "primary"}}
+			]
+		}
+	}]
+}`
+
+func decodeJSONValue(t *testing.T, data []byte) interface{} {
+	t.Helper()
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("unexpected error decoding JSON: %v", err)
+	}
+
+	return v
+}
+
+func TestParseMessageRoundTrip(t *testing.T) {
+	msg, err := ParseMessage([]byte(parseTestMessage))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	card := msg.Attachments[0].Content
+
+	if card.UnknownFields["designerVersion"] == nil || card.MSTeams.UnknownFields["theme"] == nil {
+		t.Errorf("unknown card fields not preserved: %v, %v", card.UnknownFields, card.MSTeams.UnknownFields)
+	}
+
+	if width, ok := card.Body[1].Columns[0].Width.(int); !ok || width != 2 {
+		t.Errorf("got column width %v (%T); want int 2", card.Body[1].Columns[0].Width, card.Body[1].Columns[0].Width)
+	}
+
+	payload, err := msg.PayloadSnapshot()
+	if err != nil {
+		t.Fatalf("unexpected error encoding message: %v", err)
+	}
+
+	got := decodeJSONValue(t, payload)
+	want := decodeJSONValue(t, []byte(parseTestMessage))
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch\ngot:  %s\nwant: %s", payload, parseTestMessage)
+	}
+
+	title, err := card.GetElement("title")
+	if err != nil {
+		t.Fatalf("unexpected error retrieving element: %v", err)
+	}
+
+	title.Text = "Service restored"
+
+	payload, err = msg.PayloadSnapshot()
+	if err != nil {
+		t.Fatalf("unexpected error encoding message: %v", err)
+	}
+
+	want = decodeJSONValue(t, []byte(strings.Replace(parseTestMessage, `"text": "Service alert"`, `"text": "Service restored"`, 1)))
+
+	if got := decodeJSONValue(t, payload); !reflect.DeepEqual(got, want) {
+		t.Errorf("modified message mismatch\ngot: %s", payload)
+	}
+}
+
+func TestParseCardMinimalRoundTrip(t *testing.T) {
+	const minimal = `{"type": "AdaptiveCard", "version": "1.4", "body": []}`
+
+	card, err := ParseCard([]byte(minimal))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(card)
+	if err != nil {
+		t.Fatalf("unexpected error encoding card: %v", err)
+	}
+
+	got := decodeJSONValue(t, data)
+	want := decodeJSONValue(t, []byte(minimal))
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch\ngot:  %s\nwant: %s", data, minimal)
+	}
+}
+
+func TestParseCard(t *testing.T) {
+	card, err := ParseCard([]byte(`{"type": "AdaptiveCard", "version": "1.2", "body": [
+		{"type": "TextBlock", "text": "summary", "size": "huge"}
+	]}`))
+
+	if !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+
+	if len(card.Body) != 1 || card.Body[0].Size != "huge" {
+		t.Errorf("decoded card not returned with validation error: %+v", card)
+	}
+
+	if _, err := ParseCard([]byte(`{"type": "AdaptiveCard", "body": {}}`)); err == nil ||
+		errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want decoding error", err)
+	}
+}
+
+func TestParseCardTargetElementForms(t *testing.T) {
+	card, err := ParseCard([]byte(`{"type": "AdaptiveCard", "version": "1.4", "body": [
+		{"type": "TextBlock", "id": "details", "text": "details"},
+		{"type": "TextBlock", "id": "summary", "text": "summary"}
+	], "actions": [
+		{"type": "Action.ToggleVisibility", "title": "Toggle", "targetElements": [
+			"details",
+			{"elementId": "summary", "isVisible": false}
+		]}
+	]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hidden := false
+	want := []TargetElement{
+		{ElementID: "details"},
+		{ElementID: "summary", Visible: &hidden},
+	}
+
+	if got := card.Actions[0].TargetElements; !reflect.DeepEqual(got, want) {
+		t.Errorf("got target elements %+v; want %+v", got, want)
+	}
+
+	if _, err := ParseCard([]byte(`{"type": "AdaptiveCard", "version": "1.4", "actions": [
+		{"type": "Action.ToggleVisibility", "targetElements": [1]}
+	]}`)); err == nil {
+		t.Error("got no error for numeric target element; want decoding error")
+	}
+}
+
+// newBenchmarkMessage returns a Message with a card of 20 TextBlock
+// elements and a FactSet of 10 facts.
+func newBenchmarkMessage(b *testing.B) *Message {
+	b.Helper()
+
+	card := NewCard()
+	for i := 0; i < 20; i++ {
+		card.Body = append(card.Body, NewTextBlock(fmt.Sprintf("Line %d of the <b>build & deploy</b> report", i), true))
+	}
+
+	factSet := NewFactSet()
+	for i := 0; i < 10; i++ {
+		if err := factSet.AddFact(Fact{Title: fmt.Sprintf("Fact %d", i), Value: "passed"}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	card.Body = append(card.Body, Element(factSet))
+
+	msg, err := NewMessageFromCard(card)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return msg
+}
+
+// BenchmarkMessageJSONMarshal measures encoding a Message without unknown
+// fields using json.Marshal as the baseline for BenchmarkMessagePrepare.
+func BenchmarkMessageJSONMarshal(b *testing.B) {
+	msg := newBenchmarkMessage(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMessagePrepare measures generating the JSON payload of a Message
+// without unknown fields using the pooled encoder.
+func BenchmarkMessagePrepare(b *testing.B) {
+	msg := newBenchmarkMessage(b)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := msg.Prepare(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMessagePrepareUnknownFields measures generating the JSON payload
+// of a parsed Message with unknown fields. These are encoded by appending
+// each property to a single output buffer.
+func BenchmarkMessagePrepareUnknownFields(b *testing.B) {
+	msg, err := ParseMessage([]byte(parseTestMessage))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := msg.Prepare(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
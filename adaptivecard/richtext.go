@@ -0,0 +1,139 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/validator"
+)
+
+// NewTextRun creates a new TextRun using the given text. Formatting fields
+// (e.g., Color, Weight, Highlight) may be set on the returned value.
+func NewTextRun(text string) TextRun {
+	textRun := TextRun{
+		Type: TypeElementTextRun,
+		Text: text,
+	}
+
+	return textRun
+}
+
+// NewRichTextBlock creates a new RichTextBlock element using the given
+// TextRun values. An error is returned if any of the given TextRun values
+// fail validation.
+func NewRichTextBlock(inlines ...TextRun) (Element, error) {
+	richTextBlock := Element{
+		Type: TypeElementRichTextBlock,
+	}
+
+	if err := richTextBlock.AddTextRun(inlines...); err != nil {
+		return Element{}, err
+	}
+
+	return richTextBlock, nil
+}
+
+// AddTextRun adds one or many TextRun values to a RichTextBlock element. An
+// error is returned if a TextRun fails validation or if AddTextRun is called
+// on an unsupported Element type.
+func (e *Element) AddTextRun(inlines ...TextRun) error {
+	if e.Type != TypeElementRichTextBlock {
+		return fmt.Errorf(
+			"unsupported element type %s; expected %s: %w",
+			e.Type,
+			TypeElementRichTextBlock,
+			ErrInvalidType,
+		)
+	}
+
+	if err := TextRuns(inlines).Validate(); err != nil {
+		return err
+	}
+
+	e.Inlines = append(e.Inlines, inlines...)
+
+	return nil
+}
+
+// Validate asserts that fields have valid values.
+func (tr TextRun) Validate() error {
+	v := validator.Validator{}
+
+	tr.validate(&v)
+
+	return v.Err()
+}
+
+// validate applies the validation checks of TextRun.Validate using the given
+// Validator.
+func (tr TextRun) validate(v *validator.Validator) {
+	// The Text field is required, but (as with the TextBlock element) an
+	// empty string appears to be permitted. Because of this, we avoid
+	// asserting that a value is present for the field.
+	v.FieldHasSpecificValue(tr.Type, "type", TypeElementTextRun, "TextRun", ErrInvalidType)
+	v.InListIfFieldValNotEmpty(tr.Color, "Color", "TextRun", supportedColorValues(), ErrInvalidFieldValue)
+	v.InListIfFieldValNotEmpty(tr.FontType, "FontType", "TextRun", supportedFontTypeValues(), ErrInvalidFieldValue)
+	v.InListIfFieldValNotEmpty(tr.Size, "Size", "TextRun", supportedTextSizeValues(), ErrInvalidFieldValue)
+	v.InListIfFieldValNotEmpty(tr.Weight, "Weight", "TextRun", supportedWeightValues(), ErrInvalidFieldValue)
+
+	if tr.SelectAction != nil {
+		v.SelfValidateNested(tr.SelectAction)
+	}
+}
+
+// Validate asserts that the collection of TextRun values are all valid.
+func (trs TextRuns) Validate() error {
+	for _, textRun := range trs {
+		if err := textRun.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. In addition to
+// TextRun objects, the schema permits plain strings within the inlines of a
+// RichTextBlock; these are decoded as a TextRun without formatting. Unknown
+// properties are recorded in UnknownFields.
+func (tr *TextRun) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*tr = NewTextRun(text)
+
+		return nil
+	}
+
+	// Use an alias type to prevent infinite recursion.
+	type textRun TextRun
+
+	var decoded textRun
+	if err := unmarshalWithUnknownFields(data, &decoded, &decoded.UnknownFields); err != nil {
+		return err
+	}
+
+	*tr = TextRun(decoded)
+
+	return nil
+}
+
+// assertRichTextBlockValues asserts that a RichTextBlock element contains at
+// least one TextRun.
+func assertRichTextBlockValues(e Element) error {
+	if len(e.Inlines) == 0 {
+		return fmt.Errorf(
+			"required field Inlines is empty for element type %s: %w",
+			e.Type,
+			ErrMissingValue,
+		)
+	}
+
+	return nil
+}
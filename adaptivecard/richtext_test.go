@@ -0,0 +1,67 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRichTextBlock(t *testing.T) {
+	host := NewTextRun("host01")
+	host.Color = ColorAttention
+	host.Weight = WeightBolder
+	host.FontType = FontTypeMonospace
+
+	rtb, err := NewRichTextBlock(
+		NewTextRun("Disk check failed on "),
+		host,
+		NewTextRun("."),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating RichTextBlock: %v", err)
+	}
+
+	if err := rtb.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	invalid := NewTextRun("host01")
+	invalid.Color = "red"
+	if err := rtb.AddTextRun(invalid); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidFieldValue)
+	}
+
+	empty := Element{Type: TypeElementRichTextBlock}
+	if err := empty.Validate(); !errors.Is(err, ErrMissingValue) {
+		t.Errorf("got error %v; want %v", err, ErrMissingValue)
+	}
+
+	textBlock := NewTextBlock("text", false)
+	if err := textBlock.AddTextRun(host); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("got error %v; want %v", err, ErrInvalidType)
+	}
+
+	var decoded Element
+	input := `{"type":"RichTextBlock","inlines":["plain ",{"type":"TextRun","text":"host01","color":"attention"}]}`
+	if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+		t.Fatalf("unexpected error decoding RichTextBlock: %v", err)
+	}
+
+	if len(decoded.Inlines) != 2 ||
+		!reflect.DeepEqual(decoded.Inlines[0], NewTextRun("plain ")) ||
+		decoded.Inlines[1].Color != ColorAttention {
+		t.Errorf("unexpected decoded inlines: %+v", decoded.Inlines)
+	}
+
+	if err := decoded.Validate(); err != nil {
+		t.Errorf("unexpected validation error for decoded RichTextBlock: %v", err)
+	}
+}
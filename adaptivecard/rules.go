@@ -0,0 +1,235 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+// RuleAnyType is used in place of an element or action type when
+// registering a custom validation rule which applies to all elements or
+// actions.
+const RuleAnyType string = "*"
+
+// ElementRuleFunc is a custom validation rule for an Element. A non-nil
+// error is returned if the Element violates the rule.
+type ElementRuleFunc func(e Element) error
+
+// ActionRuleFunc is a custom validation rule for an Action. A non-nil error
+// is returned if the Action violates the rule.
+type ActionRuleFunc func(a Action) error
+
+// CardRuleFunc is a custom validation rule for a top-level Card. A non-nil
+// error is returned if the Card violates the rule.
+type CardRuleFunc func(c Card) error
+
+// ValidationRules is a registry of custom validation rules applied in
+// addition to the default validation of a Card (see
+// TopLevelCard.ValidateWithRules and Message.ValidationRules). Violations
+// are reported as ValidationErrors along with violations found by default
+// validation.
+//
+// The zero value is an empty registry ready to use.
+type ValidationRules struct {
+	elementRules map[string][]namedElementRule
+	actionRules  map[string][]namedActionRule
+	cardRules    []namedCardRule
+}
+
+type namedElementRule struct {
+	name string
+	fn   ElementRuleFunc
+}
+
+type namedActionRule struct {
+	name string
+	fn   ActionRuleFunc
+}
+
+type namedCardRule struct {
+	name string
+	fn   CardRuleFunc
+}
+
+// NewValidationRules creates a new, empty registry of custom validation
+// rules.
+func NewValidationRules() *ValidationRules {
+	return &ValidationRules{}
+}
+
+// AddElementRule registers the named rule for elements of the given type
+// (e.g., TypeElementImage) or for all elements if RuleAnyType is given. The
+// rule is applied to every matching element of the Card tree (see
+// Card.Walk), including the elements of Action.ShowCard cards. The name is
+// recorded as the Rule of reported violations. The registry is returned to
+// allow chaining.
+func (r *ValidationRules) AddElementRule(elementType string, name string, fn ElementRuleFunc) *ValidationRules {
+	if r.elementRules == nil {
+		r.elementRules = make(map[string][]namedElementRule)
+	}
+
+	r.elementRules[elementType] = append(r.elementRules[elementType], namedElementRule{name: name, fn: fn})
+
+	return r
+}
+
+// AddActionRule registers the named rule for actions of the given type
+// (e.g., TypeActionOpenURL) or for all actions if RuleAnyType is given. The
+// rule is applied to every matching action and select action of the Card
+// tree (see Card.Walk). The name is recorded as the Rule of reported
+// violations. The registry is returned to allow chaining.
+func (r *ValidationRules) AddActionRule(actionType string, name string, fn ActionRuleFunc) *ValidationRules {
+	if r.actionRules == nil {
+		r.actionRules = make(map[string][]namedActionRule)
+	}
+
+	r.actionRules[actionType] = append(r.actionRules[actionType], namedActionRule{name: name, fn: fn})
+
+	return r
+}
+
+// AddCardRule registers the named rule for the top-level Card. The rule is
+// not applied to the Cards of Action.ShowCard actions. The name is recorded
+// as the Rule of reported violations. The registry is returned to allow
+// chaining.
+func (r *ValidationRules) AddCardRule(name string, fn CardRuleFunc) *ValidationRules {
+	r.cardRules = append(r.cardRules, namedCardRule{name: name, fn: fn})
+
+	return r
+}
+
+// Validate applies the registered rules to the given top-level Card. A
+// ValidationErrors value recording all violations is returned if any rule is
+// violated. Default validation is not performed (see
+// TopLevelCard.ValidateWithRules).
+func (r *ValidationRules) Validate(c Card) error {
+	if errs := r.errors(c); len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// errors returns a ValidationError for every violation of the registered
+// rules by the given top-level Card.
+func (r *ValidationRules) errors(c Card) ValidationErrors {
+	if r == nil {
+		return nil
+	}
+
+	var errs ValidationErrors
+
+	record := func(path string, name string, err error) {
+		if err == nil {
+			return
+		}
+
+		validationErr := newValidationError(path, err)
+		validationErr.Rule = name
+
+		errs = append(errs, validationErr)
+	}
+
+	for _, rule := range r.cardRules {
+		record("", rule.name, rule.fn(c))
+	}
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		switch {
+		case node.Element != nil:
+			for _, rule := range r.elementRulesFor(node.Element.Type) {
+				record(node.Path, rule.name, rule.fn(*node.Element))
+			}
+
+		case node.Action != nil:
+			for _, rule := range r.actionRulesFor(node.Action.Type) {
+				record(node.Path, rule.name, rule.fn(*node.Action))
+			}
+
+		case node.SelectAction != nil:
+			for _, rule := range r.actionRulesFor(node.SelectAction.Type) {
+				record(node.Path, rule.name, rule.fn(selectActionAsAction(*node.SelectAction)))
+			}
+		}
+
+		return nil
+	})
+
+	return errs
+}
+
+// elementRulesFor returns the rules registered for the given element type
+// followed by the rules registered for all element types.
+func (r *ValidationRules) elementRulesFor(elementType string) []namedElementRule {
+	rules := make([]namedElementRule, 0, len(r.elementRules[elementType])+len(r.elementRules[RuleAnyType]))
+	rules = append(rules, r.elementRules[elementType]...)
+
+	if elementType != RuleAnyType {
+		rules = append(rules, r.elementRules[RuleAnyType]...)
+	}
+
+	return rules
+}
+
+// actionRulesFor returns the rules registered for the given action type
+// followed by the rules registered for all action types.
+func (r *ValidationRules) actionRulesFor(actionType string) []namedActionRule {
+	rules := make([]namedActionRule, 0, len(r.actionRules[actionType])+len(r.actionRules[RuleAnyType]))
+	rules = append(rules, r.actionRules[actionType]...)
+
+	if actionType != RuleAnyType {
+		rules = append(rules, r.actionRules[RuleAnyType]...)
+	}
+
+	return rules
+}
+
+// selectActionAsAction converts the given ISelectAction to the equivalent
+// Action for evaluation by action rules. All fields shared by the two types
+// are copied. Fallback actions are evaluated separately and are not
+// included.
+func selectActionAsAction(i ISelectAction) Action {
+	return Action{
+		Type:             i.Type,
+		ID:               i.ID,
+		Title:            i.Title,
+		URL:              i.URL,
+		Fallback:         i.Fallback,
+		Requires:         i.Requires,
+		TargetElements:   i.TargetElements,
+		Tooltip:          i.Tooltip,
+		IsEnabled:        i.IsEnabled,
+		Data:             i.Data,
+		Verb:             i.Verb,
+		AssociatedInputs: i.AssociatedInputs,
+		UnknownFields:    i.UnknownFields,
+	}
+}
+
+// ValidateWithRules asserts that fields have valid values (see
+// TopLevelCard.Validate) and that the given custom validation rules are
+// satisfied. A ValidationErrors value recording the violations found by
+// default validation followed by the violations of custom rules is returned.
+// If rules is nil, only default validation is performed.
+func (tc TopLevelCard) ValidateWithRules(rules *ValidationRules) error {
+	err := tc.Validate()
+
+	ruleErrs := rules.errors(tc.Card)
+	if len(ruleErrs) == 0 {
+		return err
+	}
+
+	if err == nil {
+		return ruleErrs
+	}
+
+	// TopLevelCard.Validate reports violations as ValidationErrors.
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	return append(errs, ruleErrs...)
+}
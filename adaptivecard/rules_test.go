@@ -0,0 +1,177 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var errRuleViolation = errors.New("rule violation")
+
+func testValidationRules() *ValidationRules {
+	return NewValidationRules().
+		AddCardRule("severityFactSet", func(c Card) error {
+			for _, factSet := range c.FindElementsByType(TypeElementFactSet) {
+				for _, fact := range factSet.Facts {
+					if fact.Title == "Severity" {
+						return nil
+					}
+				}
+			}
+
+			return fmt.Errorf("card has no Severity fact: %w", errRuleViolation)
+		}).
+		AddElementRule(TypeElementImage, "internalImageHost", func(e Element) error {
+			if !strings.HasPrefix(e.URL, "https://images.example.com/") {
+				return fmt.Errorf("image URL %q uses external host: %w", e.URL, errRuleViolation)
+			}
+
+			return nil
+		}).
+		AddActionRule(RuleAnyType, "actionTitle", func(a Action) error {
+			if a.Title == "" {
+				return fmt.Errorf("action has no title: %w", errRuleViolation)
+			}
+
+			return nil
+		})
+}
+
+func TestValidationRules(t *testing.T) {
+	image := NewImage("https://cdn.example.org/logo.png", "logo")
+	image.SelectAction = &ISelectAction{Type: TypeActionOpenURL, URL: "https://example.com"}
+
+	c := NewCard()
+	c.Body = []Element{
+		NewTextBlock("summary", true),
+		NewImage("https://images.example.com/status.png", "status"),
+		image,
+	}
+	c.Actions = []Action{{Type: TypeActionOpenURL, Title: "Open", URL: "https://example.com"}}
+
+	err := TopLevelCard{Card: c}.ValidateWithRules(testValidationRules())
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	var got []string
+	for _, validationErr := range validationErrs {
+		got = append(got, validationErr.Path+" "+validationErr.Rule)
+	}
+
+	want := []string{
+		" severityFactSet",
+		"/body/2 internalImageHost",
+		"/body/2/selectAction actionTitle",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got violations %q; want %q", got, want)
+	}
+
+	if !errors.Is(err, errRuleViolation) {
+		t.Errorf("error %v does not match %v", err, errRuleViolation)
+	}
+}
+
+func TestValidationRulesWithDefaultErrors(t *testing.T) {
+	severity := Element(NewFactSet())
+	severity.Facts = []Fact{{Title: "Severity", Value: "high"}}
+
+	c := NewCard()
+	c.Body = []Element{severity, {Type: "Bogus"}}
+	c.Actions = []Action{{Type: TypeActionOpenURL, URL: "https://example.com"}}
+
+	err := TopLevelCard{Card: c}.ValidateWithRules(testValidationRules())
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	if len(validationErrs) != 2 {
+		t.Fatalf("got %d violations; want 2 (error: %v)", len(validationErrs), err)
+	}
+
+	if !errors.Is(validationErrs[0], ErrInvalidType) || validationErrs[0].Path != "/body/1" {
+		t.Errorf("got default violation %v; want %v at %q", validationErrs[0], ErrInvalidType, "/body/1")
+	}
+
+	if validationErrs[1].Rule != "actionTitle" || validationErrs[1].Path != "/actions/0" {
+		t.Errorf("got custom violation %v (rule %q); want rule %q at %q",
+			validationErrs[1], validationErrs[1].Rule, "actionTitle", "/actions/0")
+	}
+}
+
+func TestValidationRulesSelectAction(t *testing.T) {
+	rules := NewValidationRules().
+		AddActionRule(TypeActionExecute, "executeVerb", func(a Action) error {
+			if a.Verb == "" || a.AssociatedInputs == "" {
+				return fmt.Errorf("action has no verb or associated inputs: %w", errRuleViolation)
+			}
+
+			return nil
+		})
+
+	container := NewContainer()
+	container.Items = []Element{NewTextBlock("summary", true)}
+	container.SelectAction = &ISelectAction{
+		Type:             TypeActionExecute,
+		Verb:             "acknowledge",
+		AssociatedInputs: AssociatedInputsNone,
+	}
+
+	c := NewCard()
+	c.Body = []Element{Element(container)}
+	c.Actions = []Action{{
+		Type:             TypeActionExecute,
+		Title:            "Acknowledge",
+		Verb:             "acknowledge",
+		AssociatedInputs: AssociatedInputsNone,
+	}}
+
+	if err := (TopLevelCard{Card: c}).ValidateWithRules(rules); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	c.Body[0].SelectAction.Verb = ""
+
+	err := TopLevelCard{Card: c}.ValidateWithRules(rules)
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 1 ||
+		validationErrs[0].Path != "/body/0/selectAction" {
+		t.Errorf("got error %v; want executeVerb violation at %q", err, "/body/0/selectAction")
+	}
+}
+
+func TestMessageValidationRules(t *testing.T) {
+	c := NewCard()
+	c.Body = []Element{NewTextBlock("summary", true)}
+
+	msg, err := NewMessageFromCard(c)
+	if err != nil {
+		t.Fatalf("unexpected error creating message: %v", err)
+	}
+
+	if err := msg.Validate(); err != nil {
+		t.Fatalf("unexpected error without rules: %v", err)
+	}
+
+	msg.ValidationRules = testValidationRules()
+
+	if err := msg.Validate(); !errors.Is(err, errRuleViolation) {
+		t.Errorf("got error %v; want %v", err, errRuleViolation)
+	}
+}
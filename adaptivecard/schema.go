@@ -0,0 +1,361 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonschema"
+)
+
+// Schema definitions used to validate JSON payloads.
+const (
+	schemaRefAdaptiveCard string = "#/definitions/AdaptiveCard"
+	schemaRefMessage      string = "#/definitions/Message"
+)
+
+// schemaVersions lists the card versions with a bundled schema document (see
+// adaptiveCardSchemaDocuments) in ascending order.
+var schemaVersions = []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5"}
+
+// schemaCache holds the parsed schema documents, keyed by schema version (see
+// schemaVersions).
+var schemaCache = struct {
+	sync.Mutex
+	schemas map[string]*jsonschema.Schema
+}{
+	schemas: make(map[string]*jsonschema.Schema),
+}
+
+// ValidateSchema validates the given JSON payload against the bundled
+// Adaptive Card JSON schema without network access. The payload is either a
+// Message (e.g., as prepared by Message.Prepare) or a single Adaptive Card.
+//
+// Each card is validated against the schema for its declared version; the
+// elements and actions introduced in later schema versions are rejected.
+// Unknown properties are allowed as in the official schema. Declared
+// versions are mapped to the closest earlier bundled schema version (1.0
+// through 1.5) and cards without a valid version are validated against the
+// schema for the latest version. The cards of Action.ShowCard actions are
+// validated against the schema of the top-level card.
+//
+// Microsoft Teams renders some features in earlier card versions than
+// recorded by the schema (see TeamsFeatureVersion). The schema definitions
+// of only these features are added to the schema for such earlier versions,
+// so e.g. Table elements are accepted in version 1.4 cards. This applies to
+// single cards and to the cards of a Message alike.
+//
+// A ValidationErrors value recording all schema violations is returned if
+// the payload is invalid. Each violation wraps ErrSchemaViolation.
+//
+// Schema validation complements the validation performed by
+// Message.Validate; it catches content built outside of this package (e.g.,
+// payloads generated from templates) and fields unknown to the schema.
+func ValidateSchema(payload []byte) error {
+	instance, err := jsonschema.Decode(payload)
+	if err != nil {
+		return fmt.Errorf("error decoding JSON payload: %w", err)
+	}
+
+	var violations []jsonschema.Violation
+
+	obj, _ := instance.(map[string]interface{})
+
+	switch {
+	case obj != nil && obj["type"] == TypeMessage:
+		violations, err = validateMessageSchema(obj)
+	default:
+		violations, err = validateCardSchema(instance, "")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	errs := make(ValidationErrors, 0, len(violations))
+	for _, violation := range violations {
+		errs = append(errs, &ValidationError{
+			Path: violation.Path,
+			Rule: ValidationRuleSchema,
+			Err:  fmt.Errorf("%s: %w", violation.Message, ErrSchemaViolation),
+		})
+	}
+
+	return errs
+}
+
+// ValidateSchema validates the JSON payload generated for the Message
+// against the bundled Adaptive Card JSON schema (see ValidateSchema). The
+// Message is not modified.
+func (m *Message) ValidateSchema() error {
+	payload, err := m.PayloadSnapshot()
+	if err != nil {
+		return err
+	}
+
+	return ValidateSchema(payload)
+}
+
+// validateMessageSchema validates the given decoded Message envelope and
+// each attached card.
+func validateMessageSchema(msg map[string]interface{}) ([]jsonschema.Violation, error) {
+	schema, err := adaptiveCardSchema(schemaVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	violations, err := schema.ValidateRef(schemaRefMessage, msg, "")
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, _ := msg["attachments"].([]interface{})
+
+	for i, attachment := range attachments {
+		attachment, _ := attachment.(map[string]interface{})
+
+		content, ok := attachment["content"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		contentViolations, err := validateCardSchema(content, fmt.Sprintf("/attachments/%d/content", i))
+		if err != nil {
+			return nil, err
+		}
+
+		violations = append(violations, contentViolations...)
+	}
+
+	return violations, nil
+}
+
+// validateCardSchema validates the given decoded card located at the given
+// path against the schema for the declared card version.
+func validateCardSchema(card interface{}, path string) ([]jsonschema.Violation, error) {
+	var declared string
+
+	if obj, ok := card.(map[string]interface{}); ok {
+		declared, _ = obj["version"].(string)
+	}
+
+	schema, err := adaptiveCardSchema(schemaVersion(declared))
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.ValidateRef(schemaRefAdaptiveCard, card, path)
+}
+
+// schemaVersion returns the bundled schema version used to validate cards
+// of the given declared version. This is the latest bundled schema version
+// not later than the declared version, the earliest bundled schema version
+// for earlier declared versions or the latest bundled schema version if the
+// declared version is not a valid number.
+func schemaVersion(declared string) string {
+	latest := schemaVersions[len(schemaVersions)-1]
+
+	version, err := strconv.ParseFloat(strings.TrimSpace(declared), 64)
+	if err != nil {
+		return latest
+	}
+
+	selected := schemaVersions[0]
+	for _, candidate := range schemaVersions {
+		// Bundled schema versions are valid numbers.
+		v, _ := strconv.ParseFloat(candidate, 64)
+		if v > version {
+			break
+		}
+
+		selected = candidate
+	}
+
+	return selected
+}
+
+// adaptiveCardSchema returns the parsed bundled Adaptive Card schema for the
+// given schema version (see schemaVersions). The definitions of the features
+// Microsoft Teams renders in cards of this version are added (see
+// addTeamsFeatureDefinitions).
+func adaptiveCardSchema(version string) (*jsonschema.Schema, error) {
+	schemaCache.Lock()
+	defer schemaCache.Unlock()
+
+	if schema, ok := schemaCache.schemas[version]; ok {
+		return schema, nil
+	}
+
+	root, err := decodeSchemaDocument(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := addTeamsFeatureDefinitions(root, version); err != nil {
+		return nil, err
+	}
+
+	schema, err := jsonschema.New(root)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Adaptive Card schema version %s: %w", version, err)
+	}
+
+	schemaCache.schemas[version] = schema
+
+	return schema, nil
+}
+
+// decodeSchemaDocument decodes the bundled Adaptive Card schema document for
+// the given schema version (see schemaVersions).
+func decodeSchemaDocument(version string) (map[string]interface{}, error) {
+	doc, err := jsonschema.Decode([]byte(adaptiveCardSchemaDocuments[version]))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Adaptive Card schema version %s: %w", version, err)
+	}
+
+	root, _ := doc.(map[string]interface{})
+
+	return root, nil
+}
+
+// addTeamsFeatureDefinitions adds the schema definitions of the features
+// which Microsoft Teams renders in cards of the given schema version but
+// which were introduced by a later schema version (see TeamsFeatureVersion)
+// to the given decoded schema document for that version. Only these
+// features are added; the remaining definitions are left as-is.
+//
+// An element feature (e.g., "Table") is added to each union of the document
+// which lists it in the later schema (e.g., "Element"). A property feature
+// (e.g., "TextBlock.style") is added to the properties of its owning type.
+// The definitions referenced by an added feature are copied if missing.
+func addTeamsFeatureDefinitions(root map[string]interface{}, version string) error {
+	// Bundled schema versions are valid numbers.
+	v, _ := strconv.ParseFloat(version, 64)
+
+	definitions, _ := root["definitions"].(map[string]interface{})
+
+	names := make([]string, 0, len(teamsFeatureVersionOverrides()))
+	for name := range teamsFeatureVersionOverrides() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		featureVersion, _ := FeatureVersion(name)
+		if teamsVersion, _ := TeamsFeatureVersion(name); teamsVersion > v || featureVersion <= v {
+			continue
+		}
+
+		later, err := decodeSchemaDocument(schemaVersion(strconv.FormatFloat(featureVersion, 'f', 1, 64)))
+		if err != nil {
+			return err
+		}
+
+		laterDefinitions, _ := later["definitions"].(map[string]interface{})
+
+		// Feature names are either an element type or an element type and
+		// property (e.g., "TextBlock.style").
+		parts := strings.SplitN(name, ".", 2)
+
+		switch {
+		case len(parts) == 2:
+			owner, _ := definitions[parts[0]].(map[string]interface{})
+			laterOwner, _ := laterDefinitions[parts[0]].(map[string]interface{})
+			properties, _ := owner["properties"].(map[string]interface{})
+			laterProperties, _ := laterOwner["properties"].(map[string]interface{})
+
+			property, ok := laterProperties[parts[1]]
+			if properties == nil || !ok {
+				return fmt.Errorf("no schema definition for feature %q: %w", name, jsonschema.ErrInvalidSchema)
+			}
+
+			properties[parts[1]] = property
+			copyReferencedDefinitions(definitions, laterDefinitions, property)
+
+		default:
+			definition, ok := laterDefinitions[name]
+			if !ok {
+				return fmt.Errorf("no schema definition for feature %q: %w", name, jsonschema.ErrInvalidSchema)
+			}
+
+			definitions[name] = definition
+			copyReferencedDefinitions(definitions, laterDefinitions, definition)
+
+			ref := "#/definitions/" + name
+
+			for unionName, laterUnion := range laterDefinitions {
+				if !unionListsRef(laterUnion, ref) {
+					continue
+				}
+
+				union, _ := definitions[unionName].(map[string]interface{})
+				if branches, ok := union["anyOf"].([]interface{}); ok && !unionListsRef(union, ref) {
+					union["anyOf"] = append(branches, map[string]interface{}{"$ref": ref})
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyReferencedDefinitions copies the definitions referenced by the given
+// schema, directly or indirectly, from laterDefinitions to definitions if
+// missing.
+func copyReferencedDefinitions(definitions map[string]interface{}, laterDefinitions map[string]interface{}, schema interface{}) {
+	switch v := schema.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			name := strings.TrimPrefix(ref, "#/definitions/")
+			if _, ok := definitions[name]; !ok {
+				if definition, ok := laterDefinitions[name]; ok {
+					definitions[name] = definition
+					copyReferencedDefinitions(definitions, laterDefinitions, definition)
+				}
+			}
+		}
+
+		for key, child := range v {
+			// Enum and const values are data, not schemas.
+			if key == "enum" || key == "const" {
+				continue
+			}
+
+			copyReferencedDefinitions(definitions, laterDefinitions, child)
+		}
+
+	case []interface{}:
+		for _, child := range v {
+			copyReferencedDefinitions(definitions, laterDefinitions, child)
+		}
+	}
+}
+
+// unionListsRef indicates whether the given schema definition is a union
+// (anyOf) listing the given reference as one of its branches.
+func unionListsRef(definition interface{}, ref string) bool {
+	union, _ := definition.(map[string]interface{})
+	branches, _ := union["anyOf"].([]interface{})
+
+	for _, branch := range branches {
+		if branch, ok := branch.(map[string]interface{}); ok && branch["$ref"] == ref {
+			return true
+		}
+	}
+
+	return false
+}
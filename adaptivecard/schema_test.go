@@ -0,0 +1,214 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateSchemaBuiltCards(t *testing.T) {
+	mention, err := NewMention("Jane Doe", "jane.doe@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error creating mention: %v", err)
+	}
+
+	c := walkTestCard(t)
+	c.Body = append(c.Body,
+		NewTextBlock(mention.Text+" please review", true),
+		NewImage("https://example.com/status.png", "status"),
+	)
+	c.MSTeams.Entities = append(c.MSTeams.Entities, mention)
+	c.Actions = []Action{
+		{Type: TypeActionOpenURL, Title: "Open", URL: "https://example.com"},
+		{
+			Type:           TypeActionToggleVisibility,
+			Title:          "Toggle",
+			TargetElements: []TargetElement{{ElementID: "facts"}},
+		},
+	}
+
+	msg, err := NewMessageFromCard(c)
+	if err != nil {
+		t.Fatalf("unexpected error creating message: %v", err)
+	}
+
+	if err := msg.ValidateSchema(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		wantPaths []string
+	}{
+		{
+			name: "valid card",
+			payload: `{"type": "AdaptiveCard", "version": "1.2", "body": [
+				{"type": "TextBlock", "text": "summary", "wrap": true},
+				{"type": "ActionSet", "actions": [{"type": "Action.OpenUrl", "url": "https://example.com"}]}
+			]}`,
+		},
+		{
+			name: "invalid card",
+			payload: `{"type": "AdaptiveCard", "version": "1.2", "body": [
+				{"type": "TextBlock", "text": "summary", "size": "huge"},
+				{"type": "Container", "items": [{"type": "Image", "url": "https://example.com/a.png", "colour": "red"}]},
+				{"type": "Bogus"}
+			], "actions": [{"type": "Action.OpenUrl"}]}`,
+			wantPaths: []string{
+				"/actions/0",
+				"/body/0/size",
+				"/body/2",
+			},
+		},
+		{
+			name: "unknown properties",
+			payload: `{"type": "AdaptiveCard", "version": "1.2", "body": [
+				{"type": "Image", "url": "https://example.com/a.png", "colour": "red"}
+			], "x-custom": {"id": 1}}`,
+		},
+		{
+			name: "feature unsupported by card version",
+			payload: `{"type": "AdaptiveCard", "version": "1.0", "body": [
+				{"type": "ActionSet", "actions": []},
+				{"type": "Container", "items": [], "bleed": true}
+			]}`,
+			wantPaths: []string{
+				"/body/0",
+			},
+		},
+		{
+			name: "version earlier than bundled schemas",
+			payload: `{"type": "AdaptiveCard", "version": "0.9", "body": [
+				{"type": "ActionSet", "actions": []}
+			]}`,
+			wantPaths: []string{
+				"/body/0",
+			},
+		},
+		{
+			name: "version later than bundled schemas",
+			payload: `{"type": "AdaptiveCard", "version": "1.6", "body": [
+				{"type": "Table", "rows": []}
+			]}`,
+		},
+		{
+			name: "Teams renders tables and headings in version 1.4 cards",
+			payload: `{"type": "AdaptiveCard", "version": "1.4", "body": [
+				{"type": "TextBlock", "text": "title", "style": "heading"},
+				{"type": "Table", "rows": [{"type": "TableRow", "cells": [
+					{"type": "TableCell", "items": [{"type": "TextBlock", "text": "cell"}]}
+				]}]}
+			]}`,
+		},
+		{
+			name: "Teams renders tables in version 1.4 message cards",
+			payload: `{"type": "message", "attachments": [
+				{"contentType": "application/vnd.microsoft.card.adaptive", "content":
+					{"type": "AdaptiveCard", "version": "1.4", "body": [
+						{"type": "Table", "rows": [{"type": "TableRow", "cells": [
+							{"type": "TableCell", "items": [{"type": "TextBlock", "text": "cell"}]}
+						]}]}
+					]}}
+			]}`,
+		},
+		{
+			name: "Teams feature definitions added to version 1.4 cards",
+			payload: `{"type": "AdaptiveCard", "version": "1.4", "body": [
+				{"type": "TextBlock", "text": "title", "style": "bogus"},
+				{"type": "Table", "rows": [{"type": "TableRow", "cells": [
+					{"type": "TableCell", "items": [{"type": "TextBlock", "text": "cell"}]}
+				]}], "gridStyle": "bogus"}
+			]}`,
+			wantPaths: []string{
+				"/body/0/style",
+				"/body/1/gridStyle",
+			},
+		},
+		{
+			name: "table in version 1.3 card",
+			payload: `{"type": "message", "attachments": [
+				{"contentType": "application/vnd.microsoft.card.adaptive", "content":
+					{"type": "AdaptiveCard", "version": "1.3", "body": [
+						{"type": "Table", "rows": []}
+					]}}
+			]}`,
+			wantPaths: []string{
+				"/attachments/0/content/body/0",
+			},
+		},
+		{
+			name: "message",
+			payload: `{"type": "message", "attachmentLayout": "grid", "attachments": [
+				{"contentType": "application/vnd.microsoft.card.adaptive", "content":
+					{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock"}]}}
+			]}`,
+			wantPaths: []string{
+				"/attachmentLayout",
+				"/attachments/0/content/body/0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchema([]byte(tt.payload))
+
+			if tt.wantPaths == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			var validationErrs ValidationErrors
+			if !errors.As(err, &validationErrs) {
+				t.Fatalf("got error %v; want ValidationErrors", err)
+			}
+
+			var paths []string
+			for _, validationErr := range validationErrs {
+				paths = append(paths, validationErr.Path)
+
+				if validationErr.Rule != ValidationRuleSchema || !errors.Is(validationErr, ErrSchemaViolation) {
+					t.Errorf("got rule %q and error %v; want %q and %v",
+						validationErr.Rule, validationErr, ValidationRuleSchema, ErrSchemaViolation)
+				}
+			}
+
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("got paths %q; want %q (error: %v)", paths, tt.wantPaths, err)
+			}
+		})
+	}
+}
+
+func TestSchemaVersion(t *testing.T) {
+	tests := map[string]string{
+		"":      "1.5",
+		"bogus": "1.5",
+		"0.9":   "1.0",
+		"1.0":   "1.0",
+		"1.25":  "1.2",
+		"1.4":   "1.4",
+		"1.5":   "1.5",
+		"2.0":   "1.5",
+	}
+
+	for declared, want := range tests {
+		if got := schemaVersion(declared); got != want {
+			t.Errorf("schemaVersion(%q) = %q; want %q", declared, got, want)
+		}
+	}
+}
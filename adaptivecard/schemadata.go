@@ -0,0 +1,10513 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+// JSON Schema (draft-07) documents for each supported Adaptive Card version
+// following the layout of the official per-version schemas published at
+// https://adaptivecards.io/schemas/ (e.g., 1.5.0/adaptive-card.json). Each
+// document is used as-is; definitions and properties record the schema
+// version which introduced them using the "version" keyword and unknown
+// properties are allowed. The Microsoft Teams extensions (the msteams
+// property, mention entities and the CodeBlock element) are included.
+//
+// The documents are stored as string constants as embedding files requires a
+// later Go version than supported by this module.
+const (
+	adaptiveCardSchemaJSON10 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.0.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.0 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"data": {}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"data": {}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				}
+			]
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+	adaptiveCardSchemaJSON11 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.1.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.1 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/Media"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"type": "string",
+					"pattern": "^(auto|stretch|[0-9]+px)$",
+					"version": "1.1"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"backgroundColor": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				},
+				"width": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.1"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"Media": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"type",
+				"sources"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Media"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"sources": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/MediaSource"
+					}
+				},
+				"poster": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				}
+			}
+		},
+		"MediaSource": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"mimeType": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"data": {}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"data": {}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				}
+			]
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+	adaptiveCardSchemaJSON12 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.2.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.2 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ActionSet"
+				},
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/Media"
+				},
+				{
+					"$ref": "#/definitions/RichTextBlock"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/Action.ToggleVisibility"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.ToggleVisibility"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"type": "string",
+					"pattern": "^(auto|stretch|[0-9]+px)$",
+					"version": "1.1"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"backgroundColor": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				},
+				"width": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.1"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"Media": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"type",
+				"sources"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Media"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"sources": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/MediaSource"
+					}
+				},
+				"poster": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				}
+			}
+		},
+		"MediaSource": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"mimeType": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"RichTextBlock": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"inlines"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "RichTextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"inlines": {
+					"type": "array",
+					"items": {
+						"anyOf": [
+							{
+								"$ref": "#/definitions/TextRun"
+							},
+							{
+								"type": "string"
+							}
+						]
+					}
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"TextRun": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextRun"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"highlight": {
+					"type": "boolean"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"italic": {
+					"type": "boolean"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"strikethrough": {
+					"type": "boolean"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				},
+				"fillMode": {
+					"$ref": "#/definitions/ImageFillMode",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment",
+					"version": "1.2"
+				},
+				"verticalAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.2"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"anyOf": [
+						{
+							"type": "string",
+							"const": "drop"
+						},
+						{
+							"$ref": "#/definitions/Column"
+						}
+					],
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				}
+			}
+		},
+		"ActionSet": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"actions"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ActionSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"inlineAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.2"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {}
+			}
+		},
+		"Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {}
+			}
+		},
+		"ISelect.Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/TargetElementObject"
+				}
+			]
+		},
+		"TargetElementObject": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"elementId"
+			],
+			"properties": {
+				"elementId": {
+					"type": "string"
+				},
+				"isVisible": {
+					"type": "boolean"
+				}
+			}
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+	adaptiveCardSchemaJSON13 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.3.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.3 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ActionSet"
+				},
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/Media"
+				},
+				{
+					"$ref": "#/definitions/RichTextBlock"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/Action.ToggleVisibility"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.ToggleVisibility"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"type": "string",
+					"pattern": "^(auto|stretch|[0-9]+px)$",
+					"version": "1.1"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"backgroundColor": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				},
+				"width": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.1"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"Media": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"type",
+				"sources"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Media"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"sources": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/MediaSource"
+					}
+				},
+				"poster": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				}
+			}
+		},
+		"MediaSource": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"mimeType": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"RichTextBlock": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"inlines"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "RichTextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"inlines": {
+					"type": "array",
+					"items": {
+						"anyOf": [
+							{
+								"$ref": "#/definitions/TextRun"
+							},
+							{
+								"type": "string"
+							}
+						]
+					}
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"TextRun": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextRun"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"highlight": {
+					"type": "boolean"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"italic": {
+					"type": "boolean"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"strikethrough": {
+					"type": "boolean"
+				},
+				"underline": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				},
+				"fillMode": {
+					"$ref": "#/definitions/ImageFillMode",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment",
+					"version": "1.2"
+				},
+				"verticalAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.2"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"anyOf": [
+						{
+							"type": "string",
+							"const": "drop"
+						},
+						{
+							"$ref": "#/definitions/Column"
+						}
+					],
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				}
+			}
+		},
+		"ActionSet": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"actions"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ActionSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"regex": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"inlineAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.2"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"ISelect.Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/TargetElementObject"
+				}
+			]
+		},
+		"TargetElementObject": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"elementId"
+			],
+			"properties": {
+				"elementId": {
+					"type": "string"
+				},
+				"isVisible": {
+					"type": "boolean"
+				}
+			}
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+	adaptiveCardSchemaJSON14 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.4.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.4 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ActionSet"
+				},
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/Media"
+				},
+				{
+					"$ref": "#/definitions/RichTextBlock"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.Execute"
+				},
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/Action.ToggleVisibility"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.Execute"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.ToggleVisibility"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"type": "string",
+					"pattern": "^(auto|stretch|[0-9]+px)$",
+					"version": "1.1"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"backgroundColor": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				},
+				"width": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.1"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"Media": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"type",
+				"sources"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Media"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"sources": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/MediaSource"
+					}
+				},
+				"poster": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				}
+			}
+		},
+		"MediaSource": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"mimeType": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"RichTextBlock": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"inlines"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "RichTextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"inlines": {
+					"type": "array",
+					"items": {
+						"anyOf": [
+							{
+								"$ref": "#/definitions/TextRun"
+							},
+							{
+								"type": "string"
+							}
+						]
+					}
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"TextRun": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextRun"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"highlight": {
+					"type": "boolean"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"italic": {
+					"type": "boolean"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"strikethrough": {
+					"type": "boolean"
+				},
+				"underline": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				},
+				"fillMode": {
+					"$ref": "#/definitions/ImageFillMode",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment",
+					"version": "1.2"
+				},
+				"verticalAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.2"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"anyOf": [
+						{
+							"type": "string",
+							"const": "drop"
+						},
+						{
+							"$ref": "#/definitions/Column"
+						}
+					],
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				}
+			}
+		},
+		"ActionSet": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"actions"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ActionSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"regex": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"inlineAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.2"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Execute": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Execute"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"verb": {
+					"type": "string"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"ISelect.Action.Execute": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Execute"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"verb": {
+					"type": "string"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"ISelect.Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/TargetElementObject"
+				}
+			]
+		},
+		"TargetElementObject": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"elementId"
+			],
+			"properties": {
+				"elementId": {
+					"type": "string"
+				},
+				"isVisible": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Refresh": {
+			"version": "1.4",
+			"type": "object",
+			"properties": {
+				"action": {
+					"$ref": "#/definitions/Action.Execute"
+				},
+				"userIds": {
+					"type": "array",
+					"items": {
+						"type": "string"
+					}
+				}
+			}
+		},
+		"Authentication": {
+			"version": "1.4",
+			"type": "object",
+			"properties": {
+				"text": {
+					"type": "string"
+				},
+				"connectionName": {
+					"type": "string"
+				},
+				"tokenExchangeResource": {
+					"$ref": "#/definitions/TokenExchangeResource"
+				},
+				"buttons": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/AuthCardButton"
+					}
+				}
+			}
+		},
+		"TokenExchangeResource": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"id",
+				"uri",
+				"providerId"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"uri": {
+					"type": "string"
+				},
+				"providerId": {
+					"type": "string"
+				}
+			}
+		},
+		"AuthCardButton": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type",
+				"value"
+			],
+			"properties": {
+				"type": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"image": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"refresh": {
+					"$ref": "#/definitions/Refresh",
+					"version": "1.4"
+				},
+				"authentication": {
+					"$ref": "#/definitions/Authentication",
+					"version": "1.4"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+	adaptiveCardSchemaJSON15 string = `
+{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "http://adaptivecards.io/schemas/1.5.0/adaptive-card.json",
+	"description": "Adaptive Card schema version 1.5 with Microsoft Teams extensions.",
+	"$ref": "#/definitions/AdaptiveCard",
+	"definitions": {
+		"Spacing": {
+			"type": "string",
+			"enum": [
+				"default",
+				"none",
+				"small",
+				"medium",
+				"large",
+				"extraLarge",
+				"padding"
+			]
+		},
+		"FontSize": {
+			"type": "string",
+			"enum": [
+				"default",
+				"small",
+				"medium",
+				"large",
+				"extraLarge"
+			]
+		},
+		"FontWeight": {
+			"type": "string",
+			"enum": [
+				"default",
+				"lighter",
+				"bolder"
+			]
+		},
+		"Colors": {
+			"type": "string",
+			"enum": [
+				"default",
+				"dark",
+				"light",
+				"accent",
+				"good",
+				"warning",
+				"attention"
+			]
+		},
+		"FontType": {
+			"type": "string",
+			"enum": [
+				"default",
+				"monospace"
+			]
+		},
+		"HorizontalAlignment": {
+			"type": "string",
+			"enum": [
+				"left",
+				"center",
+				"right"
+			]
+		},
+		"VerticalContentAlignment": {
+			"type": "string",
+			"enum": [
+				"top",
+				"center",
+				"bottom"
+			]
+		},
+		"ContainerStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"emphasis",
+				"good",
+				"attention",
+				"warning",
+				"accent"
+			]
+		},
+		"ActionStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"positive",
+				"destructive"
+			]
+		},
+		"ImageSize": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"stretch",
+				"small",
+				"medium",
+				"large"
+			]
+		},
+		"ImageStyle": {
+			"type": "string",
+			"enum": [
+				"default",
+				"person"
+			]
+		},
+		"ImageFillMode": {
+			"type": "string",
+			"enum": [
+				"cover",
+				"repeatHorizontally",
+				"repeatVertically",
+				"repeat"
+			]
+		},
+		"AssociatedInputs": {
+			"type": "string",
+			"enum": [
+				"auto",
+				"none"
+			]
+		},
+		"BlockElementHeight": {
+			"type": "string",
+			"pattern": "^(auto|stretch|[0-9]+px)$"
+		},
+		"PixelSize": {
+			"type": "string",
+			"pattern": "^[0-9]+px$"
+		},
+		"Requires": {
+			"type": "object",
+			"additionalProperties": {
+				"type": "string"
+			}
+		},
+		"Element": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ActionSet"
+				},
+				{
+					"$ref": "#/definitions/CodeBlock"
+				},
+				{
+					"$ref": "#/definitions/ColumnSet"
+				},
+				{
+					"$ref": "#/definitions/Container"
+				},
+				{
+					"$ref": "#/definitions/FactSet"
+				},
+				{
+					"$ref": "#/definitions/Image"
+				},
+				{
+					"$ref": "#/definitions/ImageSet"
+				},
+				{
+					"$ref": "#/definitions/Input.ChoiceSet"
+				},
+				{
+					"$ref": "#/definitions/Input.Date"
+				},
+				{
+					"$ref": "#/definitions/Input.Number"
+				},
+				{
+					"$ref": "#/definitions/Input.Text"
+				},
+				{
+					"$ref": "#/definitions/Input.Time"
+				},
+				{
+					"$ref": "#/definitions/Input.Toggle"
+				},
+				{
+					"$ref": "#/definitions/Media"
+				},
+				{
+					"$ref": "#/definitions/RichTextBlock"
+				},
+				{
+					"$ref": "#/definitions/Table"
+				},
+				{
+					"$ref": "#/definitions/TextBlock"
+				}
+			]
+		},
+		"Action": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/Action.Execute"
+				},
+				{
+					"$ref": "#/definitions/Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/Action.ShowCard"
+				},
+				{
+					"$ref": "#/definitions/Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/Action.ToggleVisibility"
+				}
+			]
+		},
+		"ISelectAction": {
+			"anyOf": [
+				{
+					"$ref": "#/definitions/ISelect.Action.Execute"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.OpenUrl"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.Submit"
+				},
+				{
+					"$ref": "#/definitions/ISelect.Action.ToggleVisibility"
+				}
+			]
+		},
+		"ElementFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Element"
+				}
+			]
+		},
+		"ActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/Action"
+				}
+			]
+		},
+		"ISelectActionFallback": {
+			"anyOf": [
+				{
+					"type": "string",
+					"const": "drop"
+				},
+				{
+					"$ref": "#/definitions/ISelectAction"
+				}
+			]
+		},
+		"TextBlock": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"maxLines": {
+					"type": "integer"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				},
+				"wrap": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"default",
+						"heading"
+					],
+					"version": "1.5"
+				}
+			}
+		},
+		"Image": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Image"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"type": "string",
+					"pattern": "^(auto|stretch|[0-9]+px)$",
+					"version": "1.1"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				},
+				"backgroundColor": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"size": {
+					"$ref": "#/definitions/ImageSize"
+				},
+				"style": {
+					"$ref": "#/definitions/ImageStyle"
+				},
+				"width": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.1"
+				}
+			}
+		},
+		"ImageSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"images"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ImageSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"images": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Image"
+					}
+				},
+				"imageSize": {
+					"$ref": "#/definitions/ImageSize"
+				}
+			}
+		},
+		"Media": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"type",
+				"sources"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Media"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"sources": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/MediaSource"
+					}
+				},
+				"poster": {
+					"type": "string"
+				},
+				"altText": {
+					"type": "string"
+				}
+			}
+		},
+		"MediaSource": {
+			"version": "1.1",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"mimeType": {
+					"type": "string"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"RichTextBlock": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"inlines"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "RichTextBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"inlines": {
+					"type": "array",
+					"items": {
+						"anyOf": [
+							{
+								"$ref": "#/definitions/TextRun"
+							},
+							{
+								"type": "string"
+							}
+						]
+					}
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"TextRun": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"text"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TextRun"
+				},
+				"text": {
+					"type": "string"
+				},
+				"color": {
+					"$ref": "#/definitions/Colors"
+				},
+				"fontType": {
+					"$ref": "#/definitions/FontType",
+					"version": "1.2"
+				},
+				"highlight": {
+					"type": "boolean"
+				},
+				"isSubtle": {
+					"type": "boolean"
+				},
+				"italic": {
+					"type": "boolean"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction"
+				},
+				"size": {
+					"$ref": "#/definitions/FontSize"
+				},
+				"strikethrough": {
+					"type": "boolean"
+				},
+				"underline": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"weight": {
+					"$ref": "#/definitions/FontWeight"
+				}
+			}
+		},
+		"FactSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"facts"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "FactSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"facts": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Fact"
+					}
+				}
+			}
+		},
+		"Fact": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"BackgroundImage": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/BackgroundImageObject"
+				}
+			]
+		},
+		"BackgroundImageObject": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"url"
+			],
+			"properties": {
+				"url": {
+					"type": "string"
+				},
+				"fillMode": {
+					"$ref": "#/definitions/ImageFillMode",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment",
+					"version": "1.2"
+				},
+				"verticalAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.2"
+				}
+			}
+		},
+		"Container": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Container"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"rtl": {
+					"type": [
+						"boolean",
+						"null"
+					],
+					"version": "1.5"
+				}
+			}
+		},
+		"ColumnSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ColumnSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Column"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"horizontalAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				}
+			}
+		},
+		"Column": {
+			"version": "1.0",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Column"
+				},
+				"id": {
+					"type": "string"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage",
+					"version": "1.2"
+				},
+				"bleed": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"anyOf": [
+						{
+							"type": "string",
+							"const": "drop"
+						},
+						{
+							"$ref": "#/definitions/Column"
+						}
+					],
+					"version": "1.2"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"rtl": {
+					"type": [
+						"boolean",
+						"null"
+					],
+					"version": "1.5"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				}
+			}
+		},
+		"ActionSet": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"actions"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "ActionSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				}
+			}
+		},
+		"Table": {
+			"version": "1.5",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Table"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"columns": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TableColumnDefinition"
+					}
+				},
+				"rows": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TableRow"
+					}
+				},
+				"firstRowAsHeaders": {
+					"type": "boolean"
+				},
+				"showGridLines": {
+					"type": "boolean"
+				},
+				"gridStyle": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"horizontalCellContentAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"verticalCellContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment"
+				}
+			}
+		},
+		"TableColumnDefinition": {
+			"version": "1.5",
+			"type": "object",
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TableColumnDefinition"
+				},
+				"width": {
+					"type": [
+						"string",
+						"number"
+					]
+				},
+				"horizontalCellContentAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"verticalCellContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment"
+				}
+			}
+		},
+		"TableRow": {
+			"version": "1.5",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TableRow"
+				},
+				"cells": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TableCell"
+					}
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"horizontalCellContentAlignment": {
+					"$ref": "#/definitions/HorizontalAlignment"
+				},
+				"verticalCellContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment"
+				}
+			}
+		},
+		"TableCell": {
+			"version": "1.5",
+			"type": "object",
+			"required": [
+				"type",
+				"items"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "TableCell"
+				},
+				"items": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction"
+				},
+				"style": {
+					"$ref": "#/definitions/ContainerStyle"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment"
+				},
+				"bleed": {
+					"type": "boolean"
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize"
+				},
+				"rtl": {
+					"type": [
+						"boolean",
+						"null"
+					]
+				}
+			}
+		},
+		"CodeBlock": {
+			"description": "Microsoft Teams extension: displays a code snippet.",
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "CodeBlock"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"codeSnippet": {
+					"type": "string"
+				},
+				"language": {
+					"type": "string"
+				},
+				"startLineNumber": {
+					"type": "integer"
+				}
+			}
+		},
+		"Input.Text": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Text"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isMultiline": {
+					"type": "boolean"
+				},
+				"maxLength": {
+					"type": "integer"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"regex": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"text",
+						"tel",
+						"url",
+						"email",
+						"password"
+					]
+				},
+				"inlineAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.2"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Number": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Number"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "number"
+				},
+				"min": {
+					"type": "number"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "number"
+				}
+			}
+		},
+		"Input.Date": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Date"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Time": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Time"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"max": {
+					"type": "string"
+				},
+				"min": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Input.Toggle": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id",
+				"title"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.Toggle"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				},
+				"valueOff": {
+					"type": "string"
+				},
+				"valueOn": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.ChoiceSet": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"id"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Input.ChoiceSet"
+				},
+				"id": {
+					"type": "string"
+				},
+				"spacing": {
+					"$ref": "#/definitions/Spacing"
+				},
+				"separator": {
+					"type": "boolean"
+				},
+				"height": {
+					"$ref": "#/definitions/BlockElementHeight"
+				},
+				"isVisible": {
+					"type": "boolean",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ElementFallback",
+					"version": "1.2"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"label": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"isRequired": {
+					"type": "boolean",
+					"version": "1.3"
+				},
+				"errorMessage": {
+					"type": "string",
+					"version": "1.3"
+				},
+				"choices": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Input.Choice"
+					}
+				},
+				"isMultiSelect": {
+					"type": "boolean"
+				},
+				"style": {
+					"type": "string",
+					"enum": [
+						"compact",
+						"expanded",
+						"filtered"
+					]
+				},
+				"value": {
+					"type": "string"
+				},
+				"placeholder": {
+					"type": "string"
+				},
+				"wrap": {
+					"type": "boolean",
+					"version": "1.2"
+				}
+			}
+		},
+		"Input.Choice": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"title",
+				"value"
+			],
+			"properties": {
+				"title": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Execute": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Execute"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"verb": {
+					"type": "string"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"ISelect.Action.Execute": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Execute"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"verb": {
+					"type": "string"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"ISelect.Action.OpenUrl": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type",
+				"url"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.OpenUrl"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"url": {
+					"type": "string"
+				}
+			}
+		},
+		"ISelect.Action.Submit": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.Submit"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"data": {},
+				"associatedInputs": {
+					"$ref": "#/definitions/AssociatedInputs",
+					"version": "1.3"
+				}
+			}
+		},
+		"ISelect.Action.ToggleVisibility": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"type",
+				"targetElements"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ToggleVisibility"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ISelectActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"targetElements": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/TargetElement"
+					}
+				}
+			}
+		},
+		"Action.ShowCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "Action.ShowCard"
+				},
+				"id": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"iconUrl": {
+					"type": "string",
+					"version": "1.1"
+				},
+				"style": {
+					"$ref": "#/definitions/ActionStyle",
+					"version": "1.2"
+				},
+				"fallback": {
+					"$ref": "#/definitions/ActionFallback",
+					"version": "1.2"
+				},
+				"tooltip": {
+					"type": "string",
+					"version": "1.5"
+				},
+				"isEnabled": {
+					"type": "boolean",
+					"version": "1.5"
+				},
+				"requires": {
+					"$ref": "#/definitions/Requires",
+					"version": "1.2"
+				},
+				"card": {
+					"$ref": "#/definitions/AdaptiveCard"
+				}
+			}
+		},
+		"TargetElement": {
+			"anyOf": [
+				{
+					"type": "string"
+				},
+				{
+					"$ref": "#/definitions/TargetElementObject"
+				}
+			]
+		},
+		"TargetElementObject": {
+			"version": "1.2",
+			"type": "object",
+			"required": [
+				"elementId"
+			],
+			"properties": {
+				"elementId": {
+					"type": "string"
+				},
+				"isVisible": {
+					"type": "boolean"
+				}
+			}
+		},
+		"Refresh": {
+			"version": "1.4",
+			"type": "object",
+			"properties": {
+				"action": {
+					"$ref": "#/definitions/Action.Execute"
+				},
+				"userIds": {
+					"type": "array",
+					"items": {
+						"type": "string"
+					}
+				}
+			}
+		},
+		"Authentication": {
+			"version": "1.4",
+			"type": "object",
+			"properties": {
+				"text": {
+					"type": "string"
+				},
+				"connectionName": {
+					"type": "string"
+				},
+				"tokenExchangeResource": {
+					"$ref": "#/definitions/TokenExchangeResource"
+				},
+				"buttons": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/AuthCardButton"
+					}
+				}
+			}
+		},
+		"TokenExchangeResource": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"id",
+				"uri",
+				"providerId"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"uri": {
+					"type": "string"
+				},
+				"providerId": {
+					"type": "string"
+				}
+			}
+		},
+		"AuthCardButton": {
+			"version": "1.4",
+			"type": "object",
+			"required": [
+				"type",
+				"value"
+			],
+			"properties": {
+				"type": {
+					"type": "string"
+				},
+				"title": {
+					"type": "string"
+				},
+				"image": {
+					"type": "string"
+				},
+				"value": {
+					"type": "string"
+				}
+			}
+		},
+		"MSTeams": {
+			"description": "Microsoft Teams extension: card width and mention entities.",
+			"type": "object",
+			"properties": {
+				"width": {
+					"type": "string",
+					"enum": [
+						"Full"
+					]
+				},
+				"allowExpand": {
+					"type": "boolean"
+				},
+				"entities": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Mention"
+					}
+				}
+			}
+		},
+		"Mention": {
+			"description": "Microsoft Teams extension: user, tag, channel or team mention.",
+			"type": "object",
+			"required": [
+				"type",
+				"text",
+				"mentioned"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "mention"
+				},
+				"text": {
+					"type": "string",
+					"pattern": "^<at>[^<]+</at>$"
+				},
+				"mentioned": {
+					"$ref": "#/definitions/Mentioned"
+				}
+			}
+		},
+		"Mentioned": {
+			"type": "object",
+			"required": [
+				"id",
+				"name"
+			],
+			"properties": {
+				"id": {
+					"type": "string"
+				},
+				"name": {
+					"type": "string"
+				},
+				"type": {
+					"type": "string",
+					"enum": [
+						"tag",
+						"channel",
+						"team"
+					]
+				}
+			}
+		},
+		"AdaptiveCard": {
+			"version": "1.0",
+			"type": "object",
+			"required": [
+				"type"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "AdaptiveCard"
+				},
+				"$schema": {
+					"type": "string"
+				},
+				"version": {
+					"type": "string"
+				},
+				"fallbackText": {
+					"type": "string"
+				},
+				"body": {
+					"type": [
+						"array",
+						"null"
+					],
+					"items": {
+						"$ref": "#/definitions/Element"
+					}
+				},
+				"actions": {
+					"type": "array",
+					"items": {
+						"$ref": "#/definitions/Action"
+					}
+				},
+				"backgroundImage": {
+					"$ref": "#/definitions/BackgroundImage"
+				},
+				"minHeight": {
+					"$ref": "#/definitions/PixelSize",
+					"version": "1.2"
+				},
+				"rtl": {
+					"type": [
+						"boolean",
+						"null"
+					],
+					"version": "1.5"
+				},
+				"speak": {
+					"type": "string"
+				},
+				"lang": {
+					"type": "string"
+				},
+				"verticalContentAlignment": {
+					"$ref": "#/definitions/VerticalContentAlignment",
+					"version": "1.1"
+				},
+				"selectAction": {
+					"$ref": "#/definitions/ISelectAction",
+					"version": "1.1"
+				},
+				"refresh": {
+					"$ref": "#/definitions/Refresh",
+					"version": "1.4"
+				},
+				"authentication": {
+					"$ref": "#/definitions/Authentication",
+					"version": "1.4"
+				},
+				"msteams": {
+					"$ref": "#/definitions/MSTeams"
+				}
+			}
+		},
+		"Message": {
+			"description": "Microsoft Teams message envelope; attached cards are validated separately.",
+			"type": "object",
+			"required": [
+				"type",
+				"attachments"
+			],
+			"properties": {
+				"type": {
+					"type": "string",
+					"const": "message"
+				},
+				"attachments": {
+					"type": "array",
+					"minItems": 1,
+					"items": {
+						"$ref": "#/definitions/Attachment"
+					}
+				},
+				"attachmentLayout": {
+					"type": "string",
+					"enum": [
+						"list",
+						"carousel"
+					]
+				}
+			}
+		},
+		"Attachment": {
+			"type": "object",
+			"required": [
+				"contentType",
+				"content"
+			],
+			"properties": {
+				"contentType": {
+					"type": "string",
+					"const": "application/vnd.microsoft.card.adaptive"
+				},
+				"contentUrl": {
+					"type": [
+						"string",
+						"null"
+					]
+				},
+				"content": {
+					"type": "object"
+				}
+			}
+		}
+	}
+}
+`
+)
+
+// adaptiveCardSchemaDocuments maps each supported Adaptive Card version to
+// its schema document.
+var adaptiveCardSchemaDocuments = map[string]string{
+	"1.0": adaptiveCardSchemaJSON10,
+	"1.1": adaptiveCardSchemaJSON11,
+	"1.2": adaptiveCardSchemaJSON12,
+	"1.3": adaptiveCardSchemaJSON13,
+	"1.4": adaptiveCardSchemaJSON14,
+	"1.5": adaptiveCardSchemaJSON15,
+}
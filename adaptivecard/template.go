@@ -0,0 +1,502 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/expression"
+)
+
+// Properties of template objects which control the expansion of the object.
+const (
+	templatePropertyData string = "$data"
+	templatePropertyWhen string = "$when"
+)
+
+// Template is a parsed Adaptive Card template as created by the Adaptive
+// Cards designer. Templates use the Adaptive Card templating language:
+//
+//   - "${expression}" bindings within strings are replaced by the value of
+//     the expression. A string consisting of a single binding is replaced by
+//     the value of the expression, retaining its type (e.g., a number or
+//     boolean) unless the property is a string (e.g., the text of a
+//     TextBlock), in which case numbers and booleans are converted to
+//     strings. Bindings which refer to missing data are left as is.
+//   - "$data" properties set the data context of an element. If the data is
+//     an array, the element is repeated for each array item.
+//   - "$when" properties omit an element unless the expression evaluates to
+//     true.
+//
+// Expressions support property access (including "$data", "$root" and
+// "$index"), arithmetic, comparison and logical operators and commonly used
+// Adaptive Expressions prebuilt functions (e.g., if, equals, concat,
+// formatNumber or formatDateTime). Expressions are evaluated locally.
+//
+// A Template is safe for concurrent use.
+type Template struct {
+	root        map[string]interface{}
+	expressions map[string]*expression.Expression
+}
+
+// ParseTemplate parses the given JSON Adaptive Card template. An error is
+// returned if the template is not a JSON object or contains an expression
+// which cannot be parsed.
+func ParseTemplate(template []byte) (*Template, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(template, &root); err != nil {
+		return nil, fmt.Errorf("error decoding template: %v: %w", err, ErrTemplateExpansion)
+	}
+
+	t := Template{
+		root:        root,
+		expressions: make(map[string]*expression.Expression),
+	}
+
+	if err := t.parseExpressions(root, ""); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// ExpandTemplate parses the given JSON Adaptive Card template and expands it
+// using the given data (see Template.Expand).
+func ExpandTemplate(template []byte, data interface{}) (Card, error) {
+	t, err := ParseTemplate(template)
+	if err != nil {
+		return Card{}, err
+	}
+
+	return t.Expand(data)
+}
+
+// Expand expands the template using the given data and returns the
+// resulting Card after validating it as a top-level card (see ParseCard).
+//
+// The data may be given as JSON ([]byte or json.RawMessage) or as a Go value
+// which is converted using its JSON encoding (e.g., a struct with json tags
+// or a map).
+//
+// An error is returned if the data cannot be converted or an expression
+// cannot be evaluated. If validation of the expanded card fails, the Card
+// is returned along with the validation error.
+func (t *Template) Expand(data interface{}) (Card, error) {
+	root, err := templateData(data)
+	if err != nil {
+		return Card{}, err
+	}
+
+	scope := expression.Scope{Data: root, Root: root}
+
+	expanded, err := t.expandObject(t.root, "", scope)
+	if err != nil {
+		return Card{}, err
+	}
+
+	if len(expanded) != 1 {
+		return Card{}, fmt.Errorf(
+			"card must expand to exactly one object; got %d: %w",
+			len(expanded), ErrTemplateExpansion,
+		)
+	}
+
+	card := coerceTemplateStrings(expanded[0], reflect.TypeOf(Card{}))
+
+	payload, err := json.Marshal(card)
+	if err != nil {
+		return Card{}, fmt.Errorf("error encoding expanded template: %v: %w", err, ErrTemplateExpansion)
+	}
+
+	return ParseCard(payload)
+}
+
+// templateData converts the given template data to the value types used by
+// expressions.
+func templateData(data interface{}) (interface{}, error) {
+	var payload []byte
+
+	switch v := data.(type) {
+	case []byte:
+		payload = v
+
+	case json.RawMessage:
+		payload = v
+
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding template data: %v: %w", err, ErrTemplateExpansion)
+		}
+
+		payload = encoded
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return nil, fmt.Errorf("error decoding template data: %v: %w", err, ErrTemplateExpansion)
+	}
+
+	return decoded, nil
+}
+
+// templateError returns an error for the template value at the given JSON
+// Pointer path.
+func templateError(path string, err error) error {
+	if path == "" {
+		path = "/"
+	}
+
+	return fmt.Errorf("%s: %v: %w", path, err, ErrTemplateExpansion)
+}
+
+// parseExpressions parses the expressions of all bindings within the given
+// template value.
+func (t *Template) parseExpressions(v interface{}, path string) error {
+	switch v := v.(type) {
+	case string:
+		segments, err := splitBindings(v)
+		if err != nil {
+			return templateError(path, err)
+		}
+
+		for _, segment := range segments {
+			if !segment.binding {
+				continue
+			}
+
+			expr, err := expression.Parse(segment.text)
+			if err != nil {
+				return templateError(path, err)
+			}
+
+			t.expressions[segment.text] = expr
+		}
+
+	case []interface{}:
+		for i, item := range v {
+			if err := t.parseExpressions(item, path+"/"+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+
+	case map[string]interface{}:
+		for _, name := range sortedKeys(v) {
+			if err := t.parseExpressions(v[name], path+"/"+jsonPointerEscape(name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandValue returns the expansion of the given template value.
+func (t *Template) expandValue(v interface{}, path string, scope expression.Scope) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		return t.expandString(v, path, scope)
+
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+
+		for i, item := range v {
+			itemPath := path + "/" + strconv.Itoa(i)
+
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				expanded, err := t.expandValue(item, itemPath, scope)
+				if err != nil {
+					return nil, err
+				}
+
+				result = append(result, expanded)
+
+				continue
+			}
+
+			// Objects within arrays may be repeated or omitted.
+			expanded, err := t.expandObject(obj, itemPath, scope)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, e := range expanded {
+				result = append(result, e)
+			}
+		}
+
+		return result, nil
+
+	case map[string]interface{}:
+		expanded, err := t.expandObject(v, path, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		// Omitted objects outside of arrays are replaced by null.
+		if len(expanded) == 0 {
+			return nil, nil
+		}
+
+		return expanded[0], nil
+
+	default:
+		return v, nil
+	}
+}
+
+// expandObject returns the expansions of the given template object: none if
+// the object is omitted by its "$when" property, one for each item of an
+// array "$data" property or a single expansion otherwise.
+func (t *Template) expandObject(obj map[string]interface{}, path string, scope expression.Scope) ([]map[string]interface{}, error) {
+	scopes := []expression.Scope{scope}
+
+	if data, ok := obj[templatePropertyData]; ok {
+		dataPath := path + "/" + jsonPointerEscape(templatePropertyData)
+
+		value, err := t.expandValue(data, dataPath, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if items, ok := value.([]interface{}); ok {
+			scopes = make([]expression.Scope, len(items))
+			for i, item := range items {
+				scopes[i] = expression.Scope{Data: item, Root: scope.Root, Index: i, HasIndex: true}
+			}
+		} else {
+			scopes[0] = expression.Scope{Data: value, Root: scope.Root}
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(scopes))
+
+	for _, s := range scopes {
+		if when, ok := obj[templatePropertyWhen]; ok {
+			value, err := t.expandValue(when, path+"/"+jsonPointerEscape(templatePropertyWhen), s)
+			if err != nil {
+				return nil, err
+			}
+
+			// Conditions referring to missing data are left as is by
+			// expandString and omit the object.
+			if b, ok := value.(bool); !ok || !b {
+				continue
+			}
+		}
+
+		expanded := make(map[string]interface{}, len(obj))
+
+		for _, name := range sortedKeys(obj) {
+			if name == templatePropertyData || name == templatePropertyWhen {
+				continue
+			}
+
+			value, err := t.expandValue(obj[name], path+"/"+jsonPointerEscape(name), s)
+			if err != nil {
+				return nil, err
+			}
+
+			expanded[name] = value
+		}
+
+		result = append(result, expanded)
+	}
+
+	return result, nil
+}
+
+// expandString returns the expansion of the bindings within the given
+// template string. A string consisting of a single binding expands to the
+// value of the expression. Bindings which evaluate to expression.Undefined
+// are left as is.
+func (t *Template) expandString(s string, path string, scope expression.Scope) (interface{}, error) {
+	segments, err := splitBindings(s)
+	if err != nil {
+		return nil, templateError(path, err)
+	}
+
+	var b strings.Builder
+
+	for _, segment := range segments {
+		if !segment.binding {
+			b.WriteString(segment.text)
+			continue
+		}
+
+		value, err := t.expressions[segment.text].Evaluate(scope)
+		if err != nil {
+			return nil, templateError(path, err)
+		}
+
+		if expression.IsUndefined(value) {
+			b.WriteString("${" + segment.text + "}")
+			continue
+		}
+
+		if len(segments) == 1 {
+			return value, nil
+		}
+
+		b.WriteString(expression.String(value))
+	}
+
+	return b.String(), nil
+}
+
+// coerceTemplateStrings converts the numbers and booleans of the given
+// expanded template value which are decoded into a string field of the given
+// type (e.g., Element.Text) to strings. Objects and arrays are converted in
+// place.
+func coerceTemplateStrings(v interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Fallback elements are decoded by ElementFallback.UnmarshalJSON.
+	if t == reflect.TypeOf(ElementFallback{}) {
+		t = reflect.TypeOf(Element{})
+	}
+
+	switch value := v.(type) {
+	case bool, float64:
+		if t.Kind() == reflect.String {
+			return expression.String(value)
+		}
+
+	case []interface{}:
+		if t.Kind() != reflect.Slice {
+			break
+		}
+
+		for i, item := range value {
+			value[i] = coerceTemplateStrings(item, t.Elem())
+		}
+
+	case map[string]interface{}:
+		if t.Kind() != reflect.Struct {
+			break
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+
+			if item, ok := value[name]; ok {
+				value[name] = coerceTemplateStrings(item, field.Type)
+			}
+		}
+	}
+
+	return v
+}
+
+// bindingSegment is a part of a template string: either literal text or the
+// expression of a "${...}" binding.
+type bindingSegment struct {
+	text    string
+	binding bool
+}
+
+// splitBindings splits the given template string into literal text and
+// bindings. Braces within string literals of expressions are ignored when
+// locating the end of a binding.
+func splitBindings(s string) ([]bindingSegment, error) {
+	var segments []bindingSegment
+
+	for s != "" {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			segments = append(segments, bindingSegment{text: s})
+			break
+		}
+
+		if start > 0 {
+			segments = append(segments, bindingSegment{text: s[:start]})
+		}
+
+		end := bindingEnd(s[start+2:])
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated binding %q", s[start:])
+		}
+
+		segments = append(segments, bindingSegment{
+			text:    s[start+2 : start+2+end],
+			binding: true,
+		})
+
+		s = s[start+2+end+1:]
+	}
+
+	return segments, nil
+}
+
+// bindingEnd returns the index of the brace closing a binding whose
+// expression starts at the beginning of s or -1 if there is none.
+func bindingEnd(s string) int {
+	depth := 0
+
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			switch c {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+
+		case c == '\'' || c == '"':
+			quote = c
+
+		case c == '{':
+			depth++
+
+		case c == '}':
+			if depth == 0 {
+				return i
+			}
+
+			depth--
+		}
+	}
+
+	return -1
+}
+
+// sortedKeys returns the sorted property names of the given object.
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// jsonPointerEscape escapes the given property name for use as a JSON
+// Pointer (RFC 6901) reference token.
+func jsonPointerEscape(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const templateTestCard = `{
+	"type": "AdaptiveCard",
+	"version": "1.4",
+	"body": [
+		{"type": "TextBlock", "text": "${title}", "size": "${if(critical, 'large', 'medium')}", "wrap": true},
+		{"type": "TextBlock", "text": "Escalated to ${owner.name}", "$when": "${exists(owner)}"},
+		{"type": "FactSet", "facts": [
+			{"$data": "${hosts}", "title": "${$index + 1}. ${name}", "value": "${formatNumber(usage, 1)}% (limit ${$root.limit}%)"}
+		]},
+		{"type": "ColumnSet", "columns": [
+			{"type": "Column", "width": "${width}", "items": [{"type": "TextBlock", "text": "${missing}"}]}
+		]}
+	]
+}`
+
+type templateTestHost struct {
+	Name  string  `json:"name"`
+	Usage float64 `json:"usage"`
+}
+
+type templateTestData struct {
+	Title    string             `json:"title"`
+	Critical bool               `json:"critical"`
+	Limit    int                `json:"limit"`
+	Width    int                `json:"width"`
+	Hosts    []templateTestHost `json:"hosts"`
+}
+
+func TestExpandTemplate(t *testing.T) {
+	want := `{
+		"type": "AdaptiveCard",
+		"version": "1.4",
+		"body": [
+			{"type": "TextBlock", "text": "Disk usage", "size": "large", "wrap": true},
+			{"type": "FactSet", "facts": [
+				{"title": "1. host01", "value": "91.5% (limit 90%)"},
+				{"title": "2. host02", "value": "95.0% (limit 90%)"}
+			]},
+			{"type": "ColumnSet", "columns": [
+				{"type": "Column", "width": 2, "items": [{"type": "TextBlock", "text": "${missing}"}]}
+			]}
+		]
+	}`
+
+	data := templateTestData{
+		Title:    "Disk usage",
+		Critical: true,
+		Limit:    90,
+		Width:    2,
+		Hosts: []templateTestHost{
+			{Name: "host01", Usage: 91.5},
+			{Name: "host02", Usage: 95},
+		},
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("unexpected error encoding data: %v", err)
+	}
+
+	for name, data := range map[string]interface{}{
+		"Go value": data,
+		"JSON":     jsonData,
+	} {
+		card, err := ExpandTemplate([]byte(templateTestCard), data)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		got, err := json.Marshal(card)
+		if err != nil {
+			t.Fatalf("%s: unexpected error encoding card: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(decodeJSONValue(t, got), decodeJSONValue(t, []byte(want))) {
+			t.Errorf("%s: got card %s; want %s", name, got, want)
+		}
+	}
+}
+
+func TestExpandTemplateStringProperties(t *testing.T) {
+	template := `{"type": "AdaptiveCard", "version": "1.4", "body": [
+		{"type": "TextBlock", "text": "${count}", "wrap": "${enabled}"},
+		{"type": "TextBlock", "text": "${enabled}", "isVisible": "${enabled}"},
+		{"type": "FactSet", "facts": [{"title": "Usage", "value": "${usage}"}]},
+		{"type": "ColumnSet", "columns": [
+			{"type": "Column", "width": "${width}", "items": [{"type": "TextBlock", "text": "${usage}"}]}
+		]}
+	]}`
+
+	data := `{"count": 42, "enabled": true, "usage": 91.5, "width": 2}`
+
+	card, err := ExpandTemplate([]byte(template), []byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if card.Body[0].Text != "42" || !card.Body[0].Wrap {
+		t.Errorf("got text %q and wrap %t; want %q and %t", card.Body[0].Text, card.Body[0].Wrap, "42", true)
+	}
+
+	if visible := card.Body[1].Visible; card.Body[1].Text != "true" || visible == nil || !*visible {
+		t.Errorf("got text %q and isVisible %v; want %q and %t", card.Body[1].Text, visible, "true", true)
+	}
+
+	if got := card.Body[2].Facts[0].Value; got != "91.5" {
+		t.Errorf("got fact value %q; want %q", got, "91.5")
+	}
+
+	column := card.Body[3].Columns[0]
+
+	if width, ok := column.Width.(int); !ok || width != 2 {
+		t.Errorf("got column width %v (%T); want int 2", column.Width, column.Width)
+	}
+
+	if got := column.Items[0].Text; got != "91.5" {
+		t.Errorf("got column text %q; want %q", got, "91.5")
+	}
+}
+
+func TestExpandTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		data     string
+		want     error
+	}{
+		{
+			name:     "invalid expression syntax",
+			template: `{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock", "text": "${title +}"}]}`,
+			data:     `{}`,
+			want:     ErrTemplateExpansion,
+		},
+		{
+			name:     "unterminated binding",
+			template: `{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock", "text": "${title"}]}`,
+			data:     `{}`,
+			want:     ErrTemplateExpansion,
+		},
+		{
+			name:     "evaluation error",
+			template: `{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock", "text": "${toUpper(count)}"}]}`,
+			data:     `{"count": 1}`,
+			want:     ErrTemplateExpansion,
+		},
+		{
+			name:     "invalid data",
+			template: `{"type": "AdaptiveCard", "version": "1.4"}`,
+			data:     `{`,
+			want:     ErrTemplateExpansion,
+		},
+		{
+			name:     "expanded card fails validation",
+			template: `{"type": "AdaptiveCard", "version": "1.4", "body": [{"type": "TextBlock", "text": "x", "size": "${size}"}]}`,
+			data:     `{"size": "huge"}`,
+			want:     ErrInvalidFieldValue,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ExpandTemplate([]byte(tt.template), []byte(tt.data)); !errors.Is(err, tt.want) {
+				t.Errorf("got error %v; want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/validator"
+)
+
+// Validation rules recorded by ValidationError values.
+const (
+	ValidationRuleEquals      string = validator.RuleEquals
+	ValidationRuleRequired    string = validator.RuleRequired
+	ValidationRuleOneOf       string = validator.RuleOneOf
+	ValidationRuleNoNilValues string = validator.RuleNoNilValues
+	ValidationRuleMinVersion  string = "minVersion"
+	ValidationRuleUnique      string = "unique"
+	ValidationRuleReference   string = "reference"
+	ValidationRuleSchema      string = "schema"
+)
+
+// ValidationError is a validation violation found within a Card tree.
+type ValidationError struct {
+	// Path is the JSON Pointer (RFC 6901) location of the offending node or
+	// value relative to the top-level card (e.g., "/body/1/items/0").
+	Path string
+
+	// Field describes the offending field, if known (e.g., "Type").
+	Field string
+
+	// Rule identifies the validation check which failed, if known (e.g.,
+	// ValidationRuleOneOf).
+	Rule string
+
+	// Value is the offending field value, if known.
+	Value interface{}
+
+	// Err is the validation error.
+	Err error
+}
+
+// Error returns the validation error prefixed with the path of the offending
+// node or value.
+func (ve *ValidationError) Error() string {
+	if ve.Path == "" {
+		return ve.Err.Error()
+	}
+
+	return ve.Path + ": " + ve.Err.Error()
+}
+
+// Unwrap returns the validation error.
+func (ve *ValidationError) Unwrap() error {
+	return ve.Err
+}
+
+// ValidationErrors is the collection of validation violations found within a
+// Card tree. It is returned by TopLevelCard.Validate.
+type ValidationErrors []*ValidationError
+
+// Error returns the messages of all recorded validation errors.
+func (ve ValidationErrors) Error() string {
+	if len(ve) == 1 {
+		return ve[0].Error()
+	}
+
+	messages := make([]string, 0, len(ve))
+	for _, err := range ve {
+		messages = append(messages, err.Error())
+	}
+
+	return fmt.Sprintf(
+		"%d validation errors: %s",
+		len(ve),
+		strings.Join(messages, "; "),
+	)
+}
+
+// Is indicates whether any of the recorded validation errors matches the
+// target error (e.g., ErrMissingValue).
+func (ve ValidationErrors) Is(target error) bool {
+	for _, err := range ve {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first recorded validation error which matches the target and
+// if so, sets the target to that error value.
+func (ve ValidationErrors) As(target interface{}) bool {
+	for _, err := range ve {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newValidationError creates a ValidationError for the given path and error.
+// The offending field, rule and value are recorded if provided by the error.
+func newValidationError(path string, err error) *ValidationError {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return &ValidationError{
+			Path:  path + ve.Path,
+			Field: ve.Field,
+			Rule:  ve.Rule,
+			Value: ve.Value,
+			Err:   ve.Err,
+		}
+	}
+
+	validationErr := ValidationError{Path: path, Err: err}
+
+	var fe *validator.FieldError
+	if errors.As(err, &fe) {
+		validationErr.Field = fe.Field
+		validationErr.Rule = fe.Rule
+		validationErr.Value = fe.Value
+	}
+
+	return &validationErr
+}
+
+// collectValidationErrors validates every node of the given TopLevelCard
+// tree (see Card.Walk) and returns a ValidationErrors value recording all
+// violations found. The given error is the first violation reported by
+// TopLevelCard.validate and is recorded if no other violations are found.
+//
+// Each node is validated once and only its own fields are validated; the
+// violations of nested nodes are recorded for the nested node.
+func collectValidationErrors(tc TopLevelCard, err error) error {
+	var errs, featureErrs ValidationErrors
+
+	if versionErr := assertValidVersionFieldValue(tc.Version); versionErr != nil {
+		errs = append(errs, &ValidationError{
+			Path:  "/version",
+			Field: "Version",
+			Value: tc.Version,
+			Err:   versionErr,
+		})
+	}
+
+	record := func(path string, validate func(v *validator.Validator)) {
+		v := validator.Validator{ReportAll: true, SkipNested: true}
+		validate(&v)
+
+		for _, nodeErr := range v.Errs() {
+			errs = append(errs, newValidationError(path, nodeErr))
+		}
+	}
+
+	card := tc.Card
+
+	// The WalkFunc never returns an error.
+	_ = card.Walk(func(node Node) error {
+		switch {
+		case node.Card != nil:
+			if node.Card.Refresh != nil {
+				record(node.Path+"/refresh", node.Card.Refresh.validate)
+			}
+
+			featureErrs = append(featureErrs, featureVersionErrors(*node.Card, node.Path)...)
+			record(node.Path, node.Card.validateProperties)
+
+		case node.Element != nil:
+			record(node.Path, node.Element.validate)
+		case node.Column != nil:
+			record(node.Path, node.Column.validate)
+		case node.TextRun != nil:
+			record(node.Path, node.TextRun.validate)
+		case node.Action != nil:
+			record(node.Path, node.Action.validate)
+		case node.SelectAction != nil:
+			record(node.Path, node.SelectAction.validate)
+		}
+
+		return nil
+	})
+
+	errs = append(errs, cardIDIntegrityErrors(tc.Card)...)
+
+	// The features of the Cards of Action.ShowCard actions are reported by
+	// each enclosing Card.
+	errs = append(errs, uniqueValidationErrors(featureErrs)...)
+
+	if len(errs) == 0 {
+		return ValidationErrors{newValidationError("", err)}
+	}
+
+	return errs
+}
+
+// uniqueValidationErrors returns the given validation errors with entries
+// for the same path, field and rule as an earlier entry removed.
+func uniqueValidationErrors(errs ValidationErrors) ValidationErrors {
+	type key struct {
+		path, field, rule string
+	}
+
+	seen := make(map[key]bool, len(errs))
+	unique := make(ValidationErrors, 0, len(errs))
+
+	for _, err := range errs {
+		k := key{path: err.Path, field: err.Field, rule: err.Rule}
+		if seen[k] {
+			continue
+		}
+
+		seen[k] = true
+		unique = append(unique, err)
+	}
+
+	return unique
+}
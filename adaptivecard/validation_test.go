@@ -0,0 +1,155 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopLevelCardValidateCollectsErrors(t *testing.T) {
+	container := Element(NewContainer())
+	container.Items = []Element{
+		NewTextBlock("valid", true),
+		{Type: "Bogus"},
+	}
+
+	details := NewTextBlock("details", true)
+	details.ID = "details"
+
+	c := NewCard()
+	c.Version = ""
+	c.Body = []Element{details, container}
+	c.Actions = []Action{
+		{Type: TypeActionOpenURL, Title: "Open"},
+		{
+			Type:           TypeActionToggleVisibility,
+			Title:          "Toggle",
+			TargetElements: []TargetElement{{ElementID: "missing"}},
+		},
+	}
+
+	err := TopLevelCard{Card: c}.Validate()
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	var paths []string
+	for _, validationErr := range validationErrs {
+		paths = append(paths, validationErr.Path)
+	}
+
+	want := []string{
+		"/version",
+		"/body/1/items/1",
+		"/actions/0",
+		"/actions/1/targetElements/0/elementId",
+	}
+
+	if !reflect.DeepEqual(paths, want) {
+		t.Fatalf("got paths %q; want %q (error: %v)", paths, want, err)
+	}
+
+	for _, target := range []error{ErrMissingValue, ErrInvalidType, ErrTargetNotFound} {
+		if !errors.Is(err, target) {
+			t.Errorf("error %v does not match %v", err, target)
+		}
+	}
+
+	if validationErrs[1].Field != "Type" || validationErrs[1].Rule != ValidationRuleOneOf ||
+		validationErrs[1].Value != "Bogus" {
+		t.Errorf(
+			"got field %q, rule %q and value %v; want %q, %q and %q",
+			validationErrs[1].Field,
+			validationErrs[1].Rule,
+			validationErrs[1].Value,
+			"Type",
+			ValidationRuleOneOf,
+			"Bogus",
+		)
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Path != "/version" {
+		t.Errorf("got %v; want ValidationError for path %q", validationErr, "/version")
+	}
+}
+
+func TestTopLevelCardValidateFeatureErrors(t *testing.T) {
+	table := NewTable()
+	table.Rows = []TableRow{{Type: TypeTableRow}}
+
+	c := NewCard()
+	c.Version = "1.2"
+	c.Body = []Element{NewTextBlock("summary", true), table, NewActionSet()}
+
+	err := TopLevelCard{Card: c}.Validate()
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	if !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("error %v does not match %v", err, ErrUnsupportedFeature)
+	}
+
+	for _, validationErr := range validationErrs {
+		if validationErr.Rule == ValidationRuleMinVersion && validationErr.Path == "/body/1" {
+			return
+		}
+	}
+
+	t.Errorf("error %v does not report Table feature at %q", err, "/body/1")
+}
+
+func TestTopLevelCardValidateValid(t *testing.T) {
+	c := NewCard()
+	c.Body = []Element{NewTextBlock("summary", true)}
+
+	if err := (TopLevelCard{Card: c}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTopLevelCardValidateReportsAllFieldErrors(t *testing.T) {
+	textBlock := NewTextBlock("summary", true)
+	textBlock.Size = "huge"
+	textBlock.Color = "pink"
+
+	container := Element(NewContainer())
+	container.Items = []Element{textBlock}
+
+	c := NewCard()
+	c.Body = []Element{container}
+
+	err := TopLevelCard{Card: c}.Validate()
+
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("got error %v; want ValidationErrors", err)
+	}
+
+	var got []string
+	for _, validationErr := range validationErrs {
+		got = append(got, validationErr.Path+" "+validationErr.Field)
+	}
+
+	// Each violation is reported once, by the node it belongs to.
+	want := []string{
+		"/body/0/items/0 Size",
+		"/body/0/items/0 Color",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q; want %q (error: %v)", got, want, err)
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Feature describes an Adaptive Card schema feature (element type, action
+// type or property) used by a Card.
+type Feature struct {
+	// Name identifies the feature. Element and action features are named
+	// after their type (e.g., "Table", "Action.Execute"); properties are
+	// named after the owning type and JSON field (e.g., "Container.bleed").
+	Name string
+
+	// Path is the JSON Pointer (RFC 6901) location of the feature relative
+	// to the Card (e.g., "/body/0/items/1").
+	Path string
+
+	// MinVersion is the minimum card Version at which Microsoft Teams
+	// renders the feature (see TeamsFeatureVersion).
+	MinVersion float64
+}
+
+// FeatureVersion returns the minimum card Version required by the Adaptive
+// Card schema to use the named feature (see Feature) and whether the feature
+// is known.
+func FeatureVersion(name string) (float64, bool) {
+	version, ok := supportedFeatureVersions()[name]
+
+	return version, ok
+}
+
+// TeamsFeatureVersion returns the minimum card Version at which Microsoft
+// Teams renders the named feature (see Feature) and whether the feature is
+// known. This is earlier than the schema version for some features (e.g.,
+// Table and TextBlock heading styles are rendered in cards declaring
+// AdaptiveCardMaxVersion).
+//
+// These versions are applied when validating cards and when determining the
+// minimum version of a card (see Card.MinimumVersion).
+func TeamsFeatureVersion(name string) (float64, bool) {
+	if version, ok := teamsFeatureVersionOverrides()[name]; ok {
+		return version, true
+	}
+
+	return FeatureVersion(name)
+}
+
+// Features returns the schema features used by the Card, including those used
+// by the Cards of Action.ShowCard actions.
+//
+// Hosts which do not support an element or action with a fallback render the
+// fallback instead. For such elements and actions only the fallback and
+// requires features and the features of the fallback content are returned.
+func (c Card) Features() []Feature {
+	fc := featureCollector{}
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(fc.visit)
+
+	return fc.features
+}
+
+// MinimumVersion returns the minimum card Version required by the features
+// used by the Card. AdaptiveCardMinVersion is returned if no features
+// require a later version.
+func (c Card) MinimumVersion() float64 {
+	minVersion := AdaptiveCardMinVersion
+
+	for _, feature := range c.Features() {
+		if feature.MinVersion > minVersion {
+			minVersion = feature.MinVersion
+		}
+	}
+
+	return minVersion
+}
+
+// SetMinimumVersion sets the Version field of the Card to the minimum version
+// required by the features used by the Card. The new version is returned.
+func (c *Card) SetMinimumVersion() string {
+	c.Version = fmt.Sprintf(AdaptiveCardVersionTmpl, c.MinimumVersion())
+
+	return c.Version
+}
+
+// assertFeaturesSupportedByCardVersion asserts that all features used by the
+// given Card are supported by the declared card Version. An empty or invalid
+// Version is ignored; the Version field is optional for Cards nested within
+// an Action.ShowCard and is validated for top-level cards by
+// assertValidVersionFieldValue.
+func assertFeaturesSupportedByCardVersion(c Card) error {
+	if errs := featureVersionErrors(c, ""); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// featureVersionErrors returns a ValidationError for every feature used by
+// the given Card which is not supported by the declared card Version (see
+// assertFeaturesSupportedByCardVersion). The given path of the Card is used
+// as the prefix for feature paths. An empty or invalid Version is ignored.
+func featureVersionErrors(c Card, path string) []*ValidationError {
+	version, err := strconv.ParseFloat(c.Version, 64)
+	if err != nil {
+		return nil
+	}
+
+	var errs []*ValidationError
+
+	for _, feature := range c.Features() {
+		if feature.MinVersion > version {
+			errs = append(errs, &ValidationError{
+				Path:  path + feature.Path,
+				Field: feature.Name,
+				Rule:  ValidationRuleMinVersion,
+				Value: c.Version,
+				Err: fmt.Errorf(
+					"feature %s requires card version %0.1f or later;"+
+						" card version is %q: %w",
+					feature.Name,
+					feature.MinVersion,
+					c.Version,
+					ErrUnsupportedFeature,
+				),
+			})
+		}
+	}
+
+	return errs
+}
+
+// featureCollector records the features used by a Card.
+type featureCollector struct {
+	features []Feature
+
+	// replaced records the paths of elements and actions with a fallback.
+	// Hosts which do not support them render the fallback content instead,
+	// so the features of their other content are not required.
+	replaced []string
+}
+
+// add records the named feature at the given path if the feature is known.
+// The optional isSet value allows recording property features
+// conditionally.
+func (fc *featureCollector) add(name string, path string, isSet bool) {
+	if !isSet {
+		return
+	}
+
+	version, ok := TeamsFeatureVersion(name)
+	if !ok {
+		return
+	}
+
+	fc.features = append(fc.features, Feature{
+		Name:       name,
+		Path:       path,
+		MinVersion: version,
+	})
+}
+
+// isReplaced indicates whether the node at the given path is part of the
+// content of an element or action with a fallback, other than the fallback
+// content itself.
+func (fc *featureCollector) isReplaced(path string) bool {
+	for _, replaced := range fc.replaced {
+		if strings.HasPrefix(path, replaced+"/") &&
+			!strings.HasPrefix(path+"/", replaced+"/fallback/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// visit records the features of the given node of the Card tree (see
+// Card.Walk).
+func (fc *featureCollector) visit(node Node) error {
+	if fc.isReplaced(node.Path) {
+		return nil
+	}
+
+	switch {
+	case node.Card != nil:
+		fc.card(node.Card, node.Path)
+	case node.Element != nil:
+		fc.element(node.Element, node.Path)
+	case node.Column != nil:
+		fc.column(node.Column, node.Path)
+	case node.TextRun != nil:
+		fc.textRun(node.TextRun, node.Path)
+	case node.Action != nil:
+		fc.action(node.Action, node.Path)
+	case node.SelectAction != nil:
+		fc.selectAction(node.SelectAction, node.Path)
+	}
+
+	return nil
+}
+
+func (fc *featureCollector) card(c *Card, path string) {
+	fc.add("AdaptiveCard.selectAction", path+"/selectAction", c.SelectAction != nil)
+	fc.add("AdaptiveCard.minHeight", path+"/minHeight", c.MinHeight != "")
+	fc.add("AdaptiveCard.verticalContentAlignment", path+"/verticalContentAlignment", c.VerticalContentAlignment != "")
+	fc.add("AdaptiveCard.rtl", path+"/rtl", c.Rtl != nil)
+	fc.add("AdaptiveCard.refresh", path+"/refresh", c.Refresh != nil)
+	fc.add("AdaptiveCard.authentication", path+"/authentication", c.Authentication != nil)
+
+	fc.backgroundImage(c.BackgroundImage, path+"/backgroundImage")
+}
+
+func (fc *featureCollector) backgroundImage(bi *BackgroundImage, path string) {
+	if bi == nil {
+		return
+	}
+
+	fc.add("BackgroundImage.fillMode", path+"/fillMode", bi.FillMode != "")
+	fc.add("BackgroundImage.horizontalAlignment", path+"/horizontalAlignment", bi.HorizontalAlignment != "")
+	fc.add("BackgroundImage.verticalAlignment", path+"/verticalAlignment", bi.VerticalAlignment != "")
+}
+
+func (fc *featureCollector) element(e *Element, path string) {
+	fc.add("Element.fallback", path+"/fallback", e.Fallback != nil)
+	fc.add("Element.requires", path+"/requires", len(e.Requires) > 0)
+
+	// The features of an element with a fallback are not required; hosts
+	// which do not support them render the fallback content instead.
+	if e.Fallback != nil {
+		fc.replaced = append(fc.replaced, path)
+
+		return
+	}
+
+	fc.add(e.Type, path, true)
+
+	fc.add("Element.isVisible", path+"/isVisible", e.Visible != nil)
+	fc.add(e.Type+".selectAction", path+"/selectAction", e.SelectAction != nil)
+	fc.add(e.Type+".style", path+"/style", e.Style != "")
+	fc.add(e.Type+".style."+e.Style, path+"/style", e.Style != "")
+	fc.add(e.Type+".fontType", path+"/fontType", e.FontType != "")
+	fc.add(e.Type+".backgroundColor", path+"/backgroundColor", e.BackgroundColor != "")
+	fc.add(e.Type+".width", path+"/width", e.Width != "")
+	fc.add(e.Type+".height", path+"/height", e.Height != "")
+	fc.add(e.Type+".bleed", path+"/bleed", e.Bleed)
+	fc.add(e.Type+".minHeight", path+"/minHeight", e.MinHeight != "")
+	fc.add(e.Type+".verticalContentAlignment", path+"/verticalContentAlignment", e.VerticalContentAlignment != "")
+	fc.add(e.Type+".backgroundImage", path+"/backgroundImage", e.BackgroundImage != nil)
+	fc.add(e.Type+".rtl", path+"/rtl", e.Rtl != nil)
+	fc.add(e.Type+".wrap", path+"/wrap", e.Wrap)
+	fc.add(e.Type+".regex", path+"/regex", e.Regex != "")
+	fc.add(e.Type+".inlineAction", path+"/inlineAction", e.InlineAction != nil)
+	fc.add("Input.label", path+"/label", e.Label != "")
+	fc.add("Input.isRequired", path+"/isRequired", e.IsRequired)
+	fc.add("Input.errorMessage", path+"/errorMessage", e.ErrorMessage != "")
+
+	fc.backgroundImage(e.BackgroundImage, path+"/backgroundImage")
+}
+
+func (fc *featureCollector) column(c *Column, path string) {
+	fc.add("Column.style."+c.Style, path+"/style", c.Style != "")
+	fc.add("Column.isVisible", path+"/isVisible", c.Visible != nil)
+	fc.add("Column.selectAction", path+"/selectAction", c.SelectAction != nil)
+	fc.add("Column.bleed", path+"/bleed", c.Bleed)
+	fc.add("Column.minHeight", path+"/minHeight", c.MinHeight != "")
+	fc.add("Column.verticalContentAlignment", path+"/verticalContentAlignment", c.VerticalContentAlignment != "")
+	fc.add("Column.backgroundImage", path+"/backgroundImage", c.BackgroundImage != nil)
+	fc.add("Column.rtl", path+"/rtl", c.Rtl != nil)
+
+	fc.backgroundImage(c.BackgroundImage, path+"/backgroundImage")
+}
+
+func (fc *featureCollector) textRun(tr *TextRun, path string) {
+	fc.add(TypeElementTextRun, path, true)
+	fc.add("TextRun.underline", path+"/underline", tr.Underline)
+	fc.add("TextRun.fontType", path+"/fontType", tr.FontType != "")
+}
+
+func (fc *featureCollector) action(a *Action, path string) {
+	fc.add("Action.fallback", path+"/fallback", a.Fallback != "" || a.FallbackAction != nil)
+	fc.add("Action.requires", path+"/requires", len(a.Requires) > 0)
+
+	// The features of an action with a fallback are not required (see
+	// featureCollector.element).
+	if a.Fallback != "" || a.FallbackAction != nil {
+		fc.replaced = append(fc.replaced, path)
+
+		return
+	}
+
+	fc.add(a.Type, path, true)
+	fc.add("Action.iconUrl", path+"/iconUrl", a.IconURL != "")
+	fc.add("Action.style", path+"/style", a.Style != "")
+	fc.add("Action.associatedInputs", path+"/associatedInputs", a.AssociatedInputs != "")
+	fc.add("Action.tooltip", path+"/tooltip", a.Tooltip != "")
+	fc.add("Action.isEnabled", path+"/isEnabled", a.IsEnabled != nil)
+}
+
+func (fc *featureCollector) selectAction(i *ISelectAction, path string) {
+	fc.add("Action.fallback", path+"/fallback", i.Fallback != "" || i.FallbackAction != nil)
+	fc.add("Action.requires", path+"/requires", len(i.Requires) > 0)
+
+	// The features of an action with a fallback are not required (see
+	// featureCollector.element).
+	if i.Fallback != "" || i.FallbackAction != nil {
+		fc.replaced = append(fc.replaced, path)
+
+		return
+	}
+
+	fc.add(i.Type, path, true)
+	fc.add("Action.associatedInputs", path+"/associatedInputs", i.AssociatedInputs != "")
+	fc.add("Action.tooltip", path+"/tooltip", i.Tooltip != "")
+	fc.add("Action.isEnabled", path+"/isEnabled", i.IsEnabled != nil)
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCardMinimumVersion(t *testing.T) {
+	card := NewCard()
+	card.Body = []Element{NewTextBlock("plain", false)}
+
+	if got := card.SetMinimumVersion(); got != "1.0" {
+		t.Errorf("got minimum version %q; want %q", got, "1.0")
+	}
+
+	// Microsoft Teams renders heading styles in cards declaring
+	// AdaptiveCardMaxVersion.
+	card.Body = append(card.Body, NewTitleTextBlock("title", false))
+	if got := card.SetMinimumVersion(); got != "1.4" {
+		t.Errorf("got minimum version %q; want %q", got, "1.4")
+	}
+
+	container := NewContainer()
+	container.Style = ContainerStyleGood
+	container.Items = []Element{NewTextBlock("plain", false)}
+	card.Body = []Element{Element(container)}
+	if got := card.SetMinimumVersion(); got != "1.2" {
+		t.Errorf("got minimum version %q; want %q", got, "1.2")
+	}
+
+	input := NewInputText("comment", "Comment")
+	nested := NewCard()
+	nested.Version = ""
+	nested.Body = []Element{input}
+
+	showCard, err := NewActionShowCard("Reply", nested)
+	if err != nil {
+		t.Fatalf("unexpected error creating action: %v", err)
+	}
+
+	card.Body = []Element{NewTextBlock("plain", false)}
+	card.Actions = []Action{showCard}
+
+	if got := card.SetMinimumVersion(); got != "1.3" {
+		t.Errorf("got minimum version %q; want %q", got, "1.3")
+	}
+
+	if err := card.Validate(); err != nil {
+		t.Errorf("unexpected validation error after setting minimum version: %v", err)
+	}
+
+	card.Version = "1.2"
+	err = card.Validate()
+	if !errors.Is(err, ErrUnsupportedFeature) {
+		t.Fatalf("got error %v; want %v", err, ErrUnsupportedFeature)
+	}
+
+	if !strings.Contains(err.Error(), "/actions/0/card/body/0/label") {
+		t.Errorf("error %q does not contain path of unsupported feature", err)
+	}
+
+	if version, ok := FeatureVersion(TypeElementTable); !ok || version != 1.5 {
+		t.Errorf("got Table version %v (known: %t); want %v", version, ok, 1.5)
+	}
+
+	if version, ok := TeamsFeatureVersion(TypeElementTable); !ok || version != AdaptiveCardMaxVersion {
+		t.Errorf("got Teams Table version %v (known: %t); want %v", version, ok, AdaptiveCardMaxVersion)
+	}
+}
+
+func TestCardMinimumVersionWithFallback(t *testing.T) {
+	cell, err := NewTableCellFromElement(NewTextBlock("cell", false))
+	if err != nil {
+		t.Fatalf("unexpected error creating table cell: %v", err)
+	}
+
+	table, err := NewTableFromTableCells([][]TableCell{{cell}}, 1, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error creating table: %v", err)
+	}
+
+	table.Fallback = NewElementFallback(NewTextBlock("table not supported", false))
+
+	fallbackAction, err := NewActionOpenURL("https://example.com/ack", "Acknowledge")
+	if err != nil {
+		t.Fatalf("unexpected error creating action: %v", err)
+	}
+
+	execute := NewActionExecute("Acknowledge", "ack", nil)
+	execute.FallbackAction = &fallbackAction
+
+	card := NewCard()
+	card.Body = []Element{table}
+	card.Actions = []Action{execute}
+
+	// Hosts which do not support the Table and Action.Execute render the
+	// fallback content, which only requires fallback support.
+	if got := card.SetMinimumVersion(); got != "1.2" {
+		t.Errorf("got minimum version %q; want %q (features: %v)", got, "1.2", card.Features())
+	}
+
+	if err := (TopLevelCard{Card: card}).Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+
+	// The fallback content is still required.
+	card.Body[0].Fallback = NewElementFallback(NewTitleTextBlock("table not supported", false))
+	card.Version = "1.2"
+
+	if err := (TopLevelCard{Card: card}).Validate(); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("got error %v; want %v", err, ErrUnsupportedFeature)
+	}
+}
+
+func TestTeamsFeatureVersionValidation(t *testing.T) {
+	// Microsoft Teams renders tables and heading styles in cards declaring
+	// AdaptiveCardMaxVersion even though the schema introduced them in
+	// version 1.5.
+	cells, err := NewTableCellsWithTextBlock([]interface{}{"cell"})
+	if err != nil {
+		t.Fatalf("unexpected error creating table cells: %v", err)
+	}
+
+	table, err := NewTableFromTableCells([][]TableCell{cells}, 0, false, true)
+	if err != nil {
+		t.Fatalf("unexpected error creating table: %v", err)
+	}
+
+	msg, err := NewSimpleMessage("text", "title", true)
+	if err != nil {
+		t.Fatalf("unexpected error creating message: %v", err)
+	}
+
+	if err := msg.Attachments[0].Content.AddElement(false, table); err != nil {
+		t.Fatalf("unexpected error adding table: %v", err)
+	}
+
+	if err := msg.Validate(); err != nil {
+		t.Errorf("unexpected error validating message: %v", err)
+	}
+
+	card := msg.Attachments[0].Content
+
+	if err := card.Validate(); err != nil {
+		t.Errorf("unexpected error validating card: %v", err)
+	}
+
+	if err := card.Card.Validate(); err != nil {
+		t.Errorf("unexpected error validating card: %v", err)
+	}
+
+	if got := card.MinimumVersion(); got != AdaptiveCardMaxVersion {
+		t.Errorf("got minimum version %v; want %v", got, AdaptiveCardMaxVersion)
+	}
+
+	textCard, err := NewTextBlockCard("hello", "Title", true)
+	if err != nil {
+		t.Fatalf("unexpected error creating card: %v", err)
+	}
+
+	if err := textCard.Validate(); err != nil {
+		t.Errorf("unexpected error validating card with title: %v", err)
+	}
+
+	// Only the versions of the overridden features are lowered.
+	container := NewContainer()
+	container.Style = ContainerStyleAccent
+	container.Items = []Element{NewTextBlock("plain", false)}
+	msg.Attachments[0].Content.Version = "1.1"
+	msg.Attachments[0].Content.Body = []Element{Element(container)}
+
+	if err := msg.Validate(); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("got error %v validating message; want %v", err, ErrUnsupportedFeature)
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Node is a node of the Card tree visited by Card.Walk. Exactly one of the
+// Card, Element, Column, TextRun, Action or SelectAction fields is set.
+//
+// The fields reference the values stored within the Card; changes made using
+// these references are applied to the Card. References may become invalid
+// once elements or actions are added to or removed from the Card.
+type Node struct {
+	// Path is the JSON Pointer (RFC 6901) location of the node relative to
+	// the walked Card (e.g., "/body/0/items/1"). The path of the walked Card
+	// is empty.
+	Path string
+
+	// Card is set for the walked Card and the Cards of Action.ShowCard
+	// actions.
+	Card *Card
+
+	// Element is set for elements, including the items of Column and
+	// TableCell values, the images of ImageSet elements and fallback
+	// elements.
+	Element *Element
+
+	// Column is set for the columns of ColumnSet elements.
+	Column *Column
+
+	// TextRun is set for the inlines of RichTextBlock elements.
+	TextRun *TextRun
+
+	// Action is set for card actions, the actions of ActionSet elements, the
+	// action of a Refresh and fallback actions.
+	Action *Action
+
+	// SelectAction is set for select actions, inline actions of Input.Text
+	// elements and their fallback actions.
+	SelectAction *ISelectAction
+}
+
+// WalkFunc is the type of the function called by Card.Walk for each node of
+// the Card tree. If the function returns ErrSkipChildren, the children of the
+// node are skipped. If the function returns any other non-nil error, the walk
+// stops and the error is returned by Card.Walk.
+type WalkFunc func(node Node) error
+
+// Walk walks the Card tree depth-first, calling fn for each node, starting
+// with the Card itself. Nodes are visited in document order: the select
+// action and refresh action of a Card, then its body elements followed by its
+// actions. Elements, actions and Cards of Action.ShowCard actions are
+// descended into.
+func (c *Card) Walk(fn WalkFunc) error {
+	w := walker{fn: fn}
+	w.card(c, "")
+
+	return w.err
+}
+
+// FindElements returns references to all elements of the Card tree (see
+// Card.Walk) for which the given function returns true.
+func (c *Card) FindElements(match func(e *Element) bool) []*Element {
+	var elements []*Element
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		if node.Element != nil && match(node.Element) {
+			elements = append(elements, node.Element)
+		}
+
+		return nil
+	})
+
+	return elements
+}
+
+// FindElementsByType returns references to all elements of the Card tree
+// (see Card.Walk) of the given type (e.g., TypeElementFactSet).
+func (c *Card) FindElementsByType(elementType string) []*Element {
+	return c.FindElements(func(e *Element) bool { return e.Type == elementType })
+}
+
+// FindActions returns references to all actions of the Card tree (see
+// Card.Walk) for which the given function returns true. Select actions are
+// not included.
+func (c *Card) FindActions(match func(a *Action) bool) []*Action {
+	var actions []*Action
+
+	// The WalkFunc never returns an error.
+	_ = c.Walk(func(node Node) error {
+		if node.Action != nil && match(node.Action) {
+			actions = append(actions, node.Action)
+		}
+
+		return nil
+	})
+
+	return actions
+}
+
+// errStopWalk is used internally to stop a walk once a match is found.
+var errStopWalk = errors.New("stop walk")
+
+// findElement returns a reference to the first element of the Card tree for
+// which the given function returns true or nil if not found. Fallback
+// content is not searched.
+func (c *Card) findElement(match func(e *Element) bool) *Element {
+	var element *Element
+
+	_ = c.Walk(func(node Node) error {
+		switch {
+		// Fallback content may reuse the IDs of the content it replaces.
+		case strings.HasSuffix(node.Path, "/fallback"):
+			return ErrSkipChildren
+
+		case node.Element != nil && match(node.Element):
+			element = node.Element
+
+			return errStopWalk
+		}
+
+		return nil
+	})
+
+	return element
+}
+
+// walker walks a Card tree. Once the WalkFunc returns an error, no further
+// nodes are visited and the error is recorded.
+type walker struct {
+	fn  WalkFunc
+	err error
+
+	// noPaths disables computing the Path of nodes, e.g., for walks which
+	// only inspect node values and are expected not to allocate.
+	noPaths bool
+}
+
+// walkNodes walks the Card tree as Card.Walk does, but without computing
+// the Path of nodes.
+func (c *Card) walkNodes(fn WalkFunc) error {
+	w := walker{fn: fn, noPaths: true}
+	w.card(c, "")
+
+	return w.err
+}
+
+// join returns the path of the named child of the node at the given path.
+func (w *walker) join(path string, name string) string {
+	if w.noPaths {
+		return ""
+	}
+
+	return path + "/" + name
+}
+
+// index returns the path of the item at index i of the collection at the
+// given path.
+func (w *walker) index(path string, i int) string {
+	if w.noPaths {
+		return ""
+	}
+
+	return path + "/" + strconv.Itoa(i)
+}
+
+// visit calls the WalkFunc for the given node and indicates whether the
+// children of the node are to be visited.
+func (w *walker) visit(node Node) bool {
+	if w.err != nil {
+		return false
+	}
+
+	err := w.fn(node)
+
+	switch {
+	case errors.Is(err, ErrSkipChildren):
+		return false
+	case err != nil:
+		w.err = err
+
+		return false
+	}
+
+	return true
+}
+
+func (w *walker) card(c *Card, path string) {
+	if !w.visit(Node{Path: path, Card: c}) {
+		return
+	}
+
+	if c.SelectAction != nil {
+		w.selectAction(c.SelectAction, w.join(path, "selectAction"))
+	}
+
+	if c.Refresh != nil && c.Refresh.Action != nil {
+		w.action(c.Refresh.Action, w.join(w.join(path, "refresh"), "action"))
+	}
+
+	w.elements(c.Body, w.join(path, "body"))
+	w.actions(c.Actions, w.join(path, "actions"))
+}
+
+func (w *walker) elements(elements []Element, path string) {
+	for i := range elements {
+		w.element(&elements[i], w.index(path, i))
+	}
+}
+
+func (w *walker) elementRefs(elements []*Element, path string) {
+	for i, element := range elements {
+		if element != nil {
+			w.element(element, w.index(path, i))
+		}
+	}
+}
+
+func (w *walker) actions(actions []Action, path string) {
+	for i := range actions {
+		w.action(&actions[i], w.index(path, i))
+	}
+}
+
+func (w *walker) element(e *Element, path string) {
+	if !w.visit(Node{Path: path, Element: e}) {
+		return
+	}
+
+	if e.SelectAction != nil {
+		w.selectAction(e.SelectAction, w.join(path, "selectAction"))
+	}
+
+	if e.InlineAction != nil {
+		w.selectAction(e.InlineAction, w.join(path, "inlineAction"))
+	}
+
+	w.elements(e.Items, w.join(path, "items"))
+	w.elements(e.Images, w.join(path, "images"))
+
+	for i := range e.Inlines {
+		w.textRun(&e.Inlines[i], w.index(w.join(path, "inlines"), i))
+	}
+
+	// The Columns field is shared by the ColumnSet and Table element types;
+	// the columns of a Table are column definitions without items.
+	if e.Type == TypeElementColumnSet {
+		for i := range e.Columns {
+			w.column(&e.Columns[i], w.index(w.join(path, "columns"), i))
+		}
+	}
+
+	for i := range e.Rows {
+		rowPath := w.index(w.join(path, "rows"), i)
+
+		for j := range e.Rows[i].Cells {
+			w.elementRefs(e.Rows[i].Cells[j].Items, w.join(w.index(w.join(rowPath, "cells"), j), "items"))
+		}
+	}
+
+	w.actions(e.Actions, w.join(path, "actions"))
+
+	if e.Fallback != nil && e.Fallback.Element != nil {
+		w.element(e.Fallback.Element, w.join(path, "fallback"))
+	}
+}
+
+func (w *walker) column(c *Column, path string) {
+	if !w.visit(Node{Path: path, Column: c}) {
+		return
+	}
+
+	if c.SelectAction != nil {
+		w.selectAction(c.SelectAction, w.join(path, "selectAction"))
+	}
+
+	w.elementRefs(c.Items, w.join(path, "items"))
+}
+
+func (w *walker) textRun(tr *TextRun, path string) {
+	if !w.visit(Node{Path: path, TextRun: tr}) {
+		return
+	}
+
+	if tr.SelectAction != nil {
+		w.selectAction(tr.SelectAction, w.join(path, "selectAction"))
+	}
+}
+
+func (w *walker) action(a *Action, path string) {
+	if !w.visit(Node{Path: path, Action: a}) {
+		return
+	}
+
+	if a.Type == TypeActionShowCard && a.Card != nil {
+		w.card(a.Card, w.join(path, "card"))
+	}
+
+	if a.FallbackAction != nil {
+		w.action(a.FallbackAction, w.join(path, "fallback"))
+	}
+}
+
+func (w *walker) selectAction(i *ISelectAction, path string) {
+	if !w.visit(Node{Path: path, SelectAction: i}) {
+		return
+	}
+
+	if i.FallbackAction != nil {
+		w.selectAction(i.FallbackAction, w.join(path, "fallback"))
+	}
+}
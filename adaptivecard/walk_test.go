@@ -0,0 +1,178 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package adaptivecard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func walkTestCard(t *testing.T) Card {
+	t.Helper()
+
+	text := NewTextBlock("column text", true)
+	text.ID = "column-text"
+
+	columnSet := NewColumnSet()
+	columnSet.Columns = []Column{{Type: TypeColumn, Items: []*Element{&text}}}
+
+	factSet := Element(NewFactSet())
+	factSet.ID = "facts"
+	factSet.Facts = []Fact{{Title: "host", Value: "host01"}}
+
+	container := Element(NewContainer())
+	container.Items = []Element{columnSet, factSet}
+
+	cell, err := NewTableCellFromElement(Element{Type: TypeElementTextBlock, ID: "cell-text", Text: "cell"})
+	if err != nil {
+		t.Fatalf("unexpected error creating table cell: %v", err)
+	}
+
+	table := NewTable()
+	table.Rows = []TableRow{{Type: TypeTableRow, Cells: []TableCell{cell}}}
+
+	detailFacts := Element(NewFactSet())
+	detailFacts.ID = "detail-facts"
+	detailFacts.Facts = []Fact{{Title: "state", Value: "DOWN"}}
+
+	details := NewCard()
+	details.Body = []Element{detailFacts}
+
+	showCard, err := NewActionShowCard("Details", details)
+	if err != nil {
+		t.Fatalf("unexpected error creating action: %v", err)
+	}
+
+	actionSet := NewActionSet()
+	actionSet.Actions = []Action{showCard}
+
+	card := NewCard()
+	card.Body = []Element{container, table, actionSet}
+
+	return card
+}
+
+func TestCardWalk(t *testing.T) {
+	card := walkTestCard(t)
+
+	var paths []string
+	err := card.Walk(func(node Node) error {
+		paths = append(paths, node.Path)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"",
+		"/body/0",
+		"/body/0/items/0",
+		"/body/0/items/0/columns/0",
+		"/body/0/items/0/columns/0/items/0",
+		"/body/0/items/1",
+		"/body/1",
+		"/body/1/rows/0/cells/0/items/0",
+		"/body/2",
+		"/body/2/actions/0",
+		"/body/2/actions/0/card",
+		"/body/2/actions/0/card/body/0",
+	}
+
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("got paths %q; want %q", paths, want)
+	}
+
+	var skipped []string
+	err = card.Walk(func(node Node) error {
+		skipped = append(skipped, node.Path)
+		if node.Element != nil && node.Element.Type == TypeElementContainer {
+			return ErrSkipChildren
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(skipped) != len(want)-4 {
+		t.Errorf("got %d nodes when skipping container children; want %d", len(skipped), len(want)-4)
+	}
+
+	errStop := errors.New("stop")
+	var visited int
+	err = card.Walk(func(node Node) error {
+		visited++
+		if node.Path == "/body/1" {
+			return errStop
+		}
+
+		return nil
+	})
+	if !errors.Is(err, errStop) || visited != 7 {
+		t.Errorf("got error %v after %d nodes; want %v after 7 nodes", err, visited, errStop)
+	}
+}
+
+func TestCardQueries(t *testing.T) {
+	card := walkTestCard(t)
+
+	for _, id := range []string{"column-text", "facts", "cell-text", "detail-facts"} {
+		element, err := card.GetElement(id)
+		if err != nil {
+			t.Errorf("unexpected error retrieving %q: %v", id, err)
+
+			continue
+		}
+
+		element.Text = "redacted"
+	}
+
+	for _, id := range []string{"column-text", "cell-text"} {
+		if element, _ := card.GetElement(id); element == nil || element.Text != "redacted" {
+			t.Errorf("change to element %q not applied to card", id)
+		}
+	}
+
+	if _, err := card.GetElement("missing"); !errors.Is(err, ErrValueNotFound) {
+		t.Errorf("got error %v; want %v", err, ErrValueNotFound)
+	}
+
+	// Fallback content is not searched.
+	fallback := NewTextBlock("fallback", true)
+	fallback.ID = "fallback-text"
+
+	withFallback := Element(NewContainer())
+	withFallback.Fallback = NewElementFallback(fallback)
+	card.Body = append(card.Body, withFallback)
+
+	if _, err := card.GetElement("fallback-text"); !errors.Is(err, ErrValueNotFound) {
+		t.Errorf("got error %v for fallback element; want %v", err, ErrValueNotFound)
+	}
+
+	factSets := card.FindElementsByType(TypeElementFactSet)
+	if len(factSets) != 2 {
+		t.Fatalf("got %d FactSet elements; want 2", len(factSets))
+	}
+
+	for _, factSet := range factSets {
+		factSet.Separator = true
+	}
+
+	if !card.Body[0].Items[1].Separator || !card.Body[2].Actions[0].Card.Body[0].Separator {
+		t.Errorf("changes to FactSet elements not applied to card")
+	}
+
+	actions := card.FindActions(func(a *Action) bool { return a.Type == TypeActionShowCard })
+	if len(actions) != 1 || actions[0].Title != "Details" {
+		t.Errorf("got actions %+v; want Action.ShowCard", actions)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+
+This is an example of a client application which uses this library to:
+
+- configure a Microsoft Teams client at construction time using options
+- generate a basic Microsoft Teams message in Adaptive Card format
+- submit the message using the configured timeout and retry settings
+
+Of note:
+
+- message is in Adaptive Card format
+- custom timeout, user agent, retry policy and proxy server
+- client-specific logger in place of the package-level logger
+- validation of known webhook URL prefixes is *enabled*
+
+*/
+
+package main
+
+import (
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+	"github.com/atc0005/go-teams-notify/v2/adaptivecard"
+)
+
+func main() {
+
+	proxyURLString := "http://proxy.example.com:3128"
+	proxyURL, err := url.Parse(proxyURLString)
+	if err != nil {
+		log.Printf(
+			"failed to parse proxy URL %q: %v",
+			proxyURLString,
+			err,
+		)
+		os.Exit(1)
+	}
+
+	// Initialize a new Microsoft Teams client. The client settings cannot be
+	// changed after construction, so the client is safe to share between
+	// goroutines.
+	mstClient := goteamsnotify.NewTeamsClient(
+		goteamsnotify.WithTimeout(10*time.Second),
+		goteamsnotify.WithUserAgent("go-teams-notify-example/1.0"),
+		goteamsnotify.WithRetryPolicy(goteamsnotify.RetryPolicy{
+			Retries: 2,
+			Delay:   2 * time.Second,
+		}),
+		goteamsnotify.WithLogger(log.New(os.Stderr, "[teams] ", log.LstdFlags)),
+		goteamsnotify.WithProxy(proxyURL),
+	)
+
+	// Set webhook url.
+	webhookUrl := "https://outlook.office.com/webhook/YOUR_WEBHOOK_URL_OF_TEAMS_CHANNEL"
+
+	// The title for message (first TextBlock element).
+	msgTitle := "Hello world"
+
+	// Formatted message body.
+	msgText := "Here are some examples of formatted stuff like " +
+		"\n * this list itself  \n * **bold** \n * *italic* \n * ***bolditalic***"
+
+	// Create message using provided formatted title and text.
+	msg, err := adaptivecard.NewSimpleMessage(msgText, msgTitle, true)
+	if err != nil {
+		log.Printf(
+			"failed to create message: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+
+	// Send the message using the configured timeout and retry settings.
+	if err := mstClient.Send(webhookUrl, msg); err != nil {
+		log.Printf(
+			"failed to send message: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+}
@@ -34,11 +34,11 @@ import (
 
 func main() {
 
-	// Initialize a new Microsoft Teams client.
-	mstClient := goteamsnotify.NewTeamsClient()
-
-	// Override the project-specific default user agent
-	mstClient.SetUserAgent("go-teams-notify-example/1.0")
+	// Initialize a new Microsoft Teams client, overriding the
+	// project-specific default user agent.
+	mstClient := goteamsnotify.NewTeamsClient(
+		goteamsnotify.WithUserAgent("go-teams-notify-example/1.0"),
+	)
 
 	// Set webhook url.
 	webhookUrl := "https://outlook.office.com/webhook/YOUR_WEBHOOK_URL_OF_TEAMS_CHANNEL"
@@ -34,24 +34,24 @@ import (
 
 func main() {
 
-	// Initialize a new Microsoft Teams client.
-	mstClient := goteamsnotify.NewTeamsClient()
+	// Initialize a new Microsoft Teams client using a custom pattern for
+	// webhook URL validation.
+	mstClient := goteamsnotify.NewTeamsClient(
+		goteamsnotify.WithWebhookURLValidationPatterns(`^https://.*\.domain\.com/.*$`),
+	)
 
 	// Set webhook url.
 	webhookUrl := "https://outlook.office.com/webhook/YOUR_WEBHOOK_URL_OF_TEAMS_CHANNEL"
 
-	// Add a custom pattern for webhook URL validation
-	mstClient.AddWebhookURLValidationPatterns(`^https://.*\.domain\.com/.*$`)
-
 	/*
-		It's also possible to use multiple patterns with one call:
+		It's also possible to use multiple patterns:
 
-		mstClient.AddWebhookURLValidationPatterns(`^https://arbitrary\.example\.com/webhook/.*$`, `^https://.*\.domain\.com/.*$`)
+		goteamsnotify.WithWebhookURLValidationPatterns(`^https://arbitrary\.example\.com/webhook/.*$`, `^https://.*\.domain\.com/.*$`)
 
 		To keep the default behavior and add a custom one, use something like
 		the following:
 
-		mstClient.AddWebhookURLValidationPatterns(DefaultWebhookURLValidationPattern, `^https://.*\.domain\.com/.*$`)
+		goteamsnotify.WithWebhookURLValidationPatterns(goteamsnotify.DefaultWebhookURLValidationPattern, `^https://.*\.domain\.com/.*$`)
 	*/
 
 	// The title for message (first TextBlock element).
@@ -35,15 +35,15 @@ import (
 
 func main() {
 
-	// Initialize a new Microsoft Teams client.
-	mstClient := goteamsnotify.NewTeamsClient()
+	// Initialize a new Microsoft Teams client with webhook URL validation
+	// disabled.
+	mstClient := goteamsnotify.NewTeamsClient(
+		goteamsnotify.WithWebhookURLValidationDisabled(),
+	)
 
 	// Set webhook url.
 	webhookUrl := "https://outlook.office.com/webhook/YOUR_WEBHOOK_URL_OF_TEAMS_CHANNEL"
 
-	// Disable webhook URL validation
-	mstClient.SkipWebhookURLValidationOnSend(true)
-
 	// The title for message (first TextBlock element).
 	msgTitle := "Hello world"
 
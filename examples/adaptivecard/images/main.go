@@ -0,0 +1,142 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+
+This is an example of a client application which uses this library to generate
+a Microsoft Teams message containing an avatar image and a set of status icons
+in Adaptive Card format.
+
+Of note:
+
+- default timeout
+- package-level logging is disabled by default
+- validation of known webhook URL formats is *enabled*
+- message submitted to Microsoft Teams consisting of title, an avatar image
+  (person style) with alternate text and an ImageSet of status icons
+
+See these links for Adaptive Card image options:
+
+- https://adaptivecards.io/explorer/Image.html
+- https://adaptivecards.io/explorer/ImageSet.html
+
+*/
+
+package main
+
+import (
+	"log"
+	"os"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+	"github.com/atc0005/go-teams-notify/v2/adaptivecard"
+)
+
+func main() {
+
+	// Initialize a new Microsoft Teams client.
+	mstClient := goteamsnotify.NewTeamsClient()
+
+	// Set webhook url.
+	//
+	// NOTE: This is for illustration purposes only. Best practice is to NOT
+	// hardcode credentials of any kind.
+	webhookUrl := "https://example.logic.azure.com:443/workflows/GUID_HERE/triggers/manual/paths/invoke?api-version=YYYY-MM-DD&sp=%2Ftriggers%2Fmanual%2Frun&sv=1.0&sig=SIGNATURE_HERE"
+
+	// Allow specifying webhook URL via environment variable, fall-back to
+	// hard-coded value in this example file.
+	expectedEnvVar := "WEBHOOK_URL"
+	envWebhookURL := os.Getenv(expectedEnvVar)
+	switch {
+	case envWebhookURL != "":
+		log.Printf(
+			"Using webhook URL %q from environment variable %q\n\n",
+			envWebhookURL,
+			expectedEnvVar,
+		)
+		webhookUrl = envWebhookURL
+	default:
+		log.Println(expectedEnvVar, "environment variable not set.")
+		log.Printf("Using hardcoded value %q as fallback\n\n", webhookUrl)
+	}
+
+	// Create card using provided title and text. We'll modify the card and
+	// when finished use it to generate a message for delivery.
+	card, err := adaptivecard.NewTextBlockCard(
+		"Disk usage on host01 exceeds the warning threshold.",
+		"Alert: host01",
+		true,
+	)
+	if err != nil {
+		log.Printf(
+			"failed to create card: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+
+	// Avatar for the on-call engineer, cropped to a circle.
+	avatar := adaptivecard.NewPersonImage(
+		"https://adaptivecards.io/content/cats/1.png",
+		"On-call engineer",
+	)
+	avatar.Size = adaptivecard.ImageSizeSmall
+
+	// Status icons with explicit pixel dimensions.
+	okIcon := adaptivecard.NewImage(
+		"https://adaptivecards.io/content/success.png",
+		"Service status: OK",
+	)
+	okIcon.Width = "24px"
+	okIcon.Height = "24px"
+
+	warnIcon := adaptivecard.NewImage(
+		"https://adaptivecards.io/content/warning.png",
+		"Disk status: Warning",
+	)
+	warnIcon.Width = "24px"
+	warnIcon.Height = "24px"
+	warnIcon.BackgroundColor = "#FFF4CE"
+
+	statusIcons, err := adaptivecard.NewImageSet(
+		adaptivecard.ImageSizeSmall,
+		okIcon,
+		warnIcon,
+	)
+	if err != nil {
+		log.Printf(
+			"failed to create image set: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+
+	// Add images to our Card.
+	if err := card.AddElement(false, avatar, statusIcons); err != nil {
+		log.Printf(
+			"failed to add images to card: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+
+	// Create Message from Card
+	msg, err := adaptivecard.NewMessageFromCard(card)
+	if err != nil {
+		log.Printf("failed to create message from card: %v", err)
+		os.Exit(1)
+	}
+
+	// Send the message with default timeout/retry settings.
+	if err := mstClient.Send(webhookUrl, msg); err != nil {
+		log.Printf(
+			"failed to send message: %v",
+			err,
+		)
+		os.Exit(1)
+	}
+}
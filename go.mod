@@ -10,4 +10,7 @@ module github.com/atc0005/go-teams-notify/v2
 
 go 1.14
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/stretchr/testify v1.10.0
+	gopkg.in/yaml.v3 v3.0.1
+)
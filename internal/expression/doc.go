@@ -0,0 +1,23 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+Package expression provides local evaluation of the subset of the Adaptive
+Expressions language used by Adaptive Card templates (e.g., the content of a
+"${...}" template binding).
+
+Supported are literals (numbers, strings, true, false and null), property
+access (e.g., "name", "host.addresses[0]", "$root.title", "$data", "$index"),
+the operators ! - * / % + < <= > >= == != && || and calls of the prebuilt
+functions listed by Functions.
+
+Values are the types produced by json.Unmarshal when decoding into an
+interface{} value: nil, bool, float64, string, []interface{} and
+map[string]interface{}. References to properties which do not exist evaluate
+to Undefined.
+*/
+package expression
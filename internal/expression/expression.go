@@ -0,0 +1,754 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrSyntax indicates that an expression could not be parsed.
+var ErrSyntax = errors.New("invalid expression syntax")
+
+// ErrEvaluation indicates that an expression could not be evaluated (e.g.,
+// an unknown function or an operand of an unsupported type).
+var ErrEvaluation = errors.New("expression evaluation failed")
+
+// Undefined is the value of references to properties which do not exist.
+var Undefined interface{} = undefined{}
+
+// undefined is the type of the Undefined value.
+type undefined struct{}
+
+// IsUndefined reports whether the given value is Undefined.
+func IsUndefined(v interface{}) bool {
+	_, ok := v.(undefined)
+	return ok
+}
+
+// Scope holds the values expressions are evaluated against.
+type Scope struct {
+	// Data is the current data context, referenced explicitly by "$data"
+	// and implicitly by property names without a prefix.
+	Data interface{}
+
+	// Root is the root data context, referenced by "$root".
+	Root interface{}
+
+	// Index is the index of the current data context within a repeated
+	// array, referenced by "$index". Index is Undefined when HasIndex is
+	// false.
+	Index int
+
+	// HasIndex indicates whether Index is set.
+	HasIndex bool
+}
+
+// Expression is a parsed expression.
+type Expression struct {
+	source string
+	root   node
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Parse parses the given expression.
+func Parse(source string) (*Expression, error) {
+	p := parser{source: source}
+
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %q", tok.text)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// Evaluate parses and evaluates the given expression against the given
+// scope.
+func Evaluate(source string, scope Scope) (interface{}, error) {
+	e, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Evaluate(scope)
+}
+
+// Evaluate evaluates the expression against the given scope.
+func (e *Expression) Evaluate(scope Scope) (interface{}, error) {
+	v, err := e.root.eval(scope)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", e.source, err)
+	}
+
+	return v, nil
+}
+
+// Truthy reports whether the given value is considered true by conditions
+// (e.g., the "&&" operator or the if function). All values other than
+// false, null and Undefined are true.
+func Truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil, undefined:
+		return false
+	case bool:
+		return v
+	default:
+		return true
+	}
+}
+
+// String returns the text representation of the given value as used when
+// values are interpolated into strings. Null and Undefined values are
+// represented by an empty string, arrays and objects by their JSON
+// encoding.
+func String(v interface{}) string {
+	switch v := v.(type) {
+	case nil, undefined:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return toJSON(v)
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type parser struct {
+	source string
+	tokens []token
+	next   int
+}
+
+// operators lists the supported operators, longest first.
+var operators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"!", "-", "+", "*", "/", "%", "<", ">", "(", ")", "[", "]", ".", ",",
+}
+
+// binaryPrecedence maps binary operators to their precedence; higher binds
+// tighter.
+var binaryPrecedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+}
+
+func (p *parser) errorf(tok token, format string, args ...interface{}) error {
+	return fmt.Errorf("%q: position %d: %s: %w",
+		p.source, tok.pos+1, fmt.Sprintf(format, args...), ErrSyntax)
+}
+
+func isIdentRune(r rune, first bool) bool {
+	if r == '_' || r == '$' || r == '@' || unicode.IsLetter(r) {
+		return true
+	}
+
+	return !first && unicode.IsDigit(r)
+}
+
+func (p *parser) tokenize() error {
+	runes := []rune(p.source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+
+			p.tokens = append(p.tokens, token{kind: tokenNumber, text: string(runes[start:i]), pos: start})
+
+		case r == '\'' || r == '"':
+			start := i
+			i++
+
+			var b strings.Builder
+			for ; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+
+				b.WriteRune(runes[i])
+			}
+
+			if i == len(runes) {
+				return p.errorf(token{pos: start}, "unterminated string")
+			}
+
+			i++
+			p.tokens = append(p.tokens, token{kind: tokenString, text: b.String(), pos: start})
+
+		case isIdentRune(r, true):
+			start := i
+			for i < len(runes) && isIdentRune(runes[i], false) {
+				i++
+			}
+
+			p.tokens = append(p.tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+
+		default:
+			matched := false
+
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					p.tokens = append(p.tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len([]rune(op))
+					matched = true
+
+					break
+				}
+			}
+
+			if !matched {
+				return p.errorf(token{pos: i}, "unexpected character %q", r)
+			}
+		}
+	}
+
+	p.tokens = append(p.tokens, token{kind: tokenEOF, pos: len(runes)})
+
+	return nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEOF {
+		p.next++
+	}
+
+	return tok
+}
+
+func (p *parser) isOperator(text string) bool {
+	tok := p.peek()
+	return tok.kind == tokenOperator && tok.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if tok := p.advance(); tok.kind != tokenOperator || tok.text != text {
+		return p.errorf(tok, "expected %q", text)
+	}
+
+	return nil
+}
+
+// parseBinary parses a sequence of unary expressions joined by binary
+// operators with a precedence of at least minPrecedence.
+func (p *parser) parseBinary(minPrecedence int) (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tok := p.peek()
+
+		precedence, ok := binaryPrecedence[tok.text]
+		if tok.kind != tokenOperator || !ok || precedence < minPrecedence {
+			return left, nil
+		}
+
+		p.advance()
+
+		right, err := p.parseBinary(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = binaryNode{op: tok.text, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("!") || p.isOperator("-") {
+		op := p.advance().text
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return unaryNode{op: op, operand: operand}, nil
+	}
+
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.isOperator("."):
+			p.advance()
+
+			tok := p.advance()
+			if tok.kind != tokenIdent {
+				return nil, p.errorf(tok, "expected property name")
+			}
+
+			n = memberNode{target: n, key: literalNode{value: tok.text}}
+
+		case p.isOperator("["):
+			p.advance()
+
+			key, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+
+			n = memberNode{target: n, key: key}
+
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.advance()
+
+	switch tok.kind {
+	case tokenNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %q", tok.text)
+		}
+
+		return literalNode{value: f}, nil
+
+	case tokenString:
+		return literalNode{value: tok.text}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "null":
+			return literalNode{value: nil}, nil
+		}
+
+		if !p.isOperator("(") {
+			return identNode{name: tok.text}, nil
+		}
+
+		p.advance()
+
+		call := callNode{name: tok.text}
+
+		for !p.isOperator(")") {
+			if len(call.args) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+
+			arg, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+
+			call.args = append(call.args, arg)
+		}
+
+		p.advance()
+
+		return call, nil
+
+	case tokenOperator:
+		if tok.text == "(" {
+			n, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+
+			return n, nil
+		}
+
+		return nil, p.errorf(tok, "unexpected %q", tok.text)
+
+	default:
+		return nil, p.errorf(tok, "unexpected end of expression")
+	}
+}
+
+// node is an element of a parsed expression.
+type node interface {
+	eval(scope Scope) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(Scope) (interface{}, error) {
+	return n.value, nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n identNode) eval(scope Scope) (interface{}, error) {
+	switch n.name {
+	case "$data":
+		return scope.Data, nil
+	case "$root":
+		return scope.Root, nil
+	case "$index":
+		if !scope.HasIndex {
+			return Undefined, nil
+		}
+
+		return float64(scope.Index), nil
+	default:
+		return member(scope.Data, n.name), nil
+	}
+}
+
+type memberNode struct {
+	target node
+	key    node
+}
+
+func (n memberNode) eval(scope Scope) (interface{}, error) {
+	target, err := n.target.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := n.key.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return member(target, key), nil
+}
+
+// member returns the property or array item of target identified by key or
+// Undefined if there is no such property or item.
+func member(target interface{}, key interface{}) interface{} {
+	switch target := target.(type) {
+	case map[string]interface{}:
+		if name, ok := key.(string); ok {
+			if v, ok := target[name]; ok {
+				return v
+			}
+		}
+
+	case []interface{}:
+		if i, ok := key.(float64); ok && i == math.Trunc(i) && i >= 0 && int(i) < len(target) {
+			return target[int(i)]
+		}
+	}
+
+	return Undefined
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n unaryNode) eval(scope Scope) (interface{}, error) {
+	v, err := n.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "!" {
+		return !Truthy(v), nil
+	}
+
+	f, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return -f, nil
+}
+
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n binaryNode) eval(scope Scope) (interface{}, error) {
+	left, err := n.left.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logical operators short-circuit.
+	switch {
+	case n.op == "&&" && !Truthy(left):
+		return false, nil
+	case n.op == "||" && Truthy(left):
+		return true, nil
+	}
+
+	right, err := n.right.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return Truthy(right), nil
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(n.op, left, right)
+	case "+":
+		_, leftString := left.(string)
+		_, rightString := right.(string)
+
+		if leftString || rightString {
+			return String(left) + String(right), nil
+		}
+	}
+
+	return arithmetic(n.op, left, right)
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+func (n callNode) eval(scope Scope) (interface{}, error) {
+	// The arguments of if are evaluated lazily to allow guarding
+	// references which are not valid for all data.
+	if n.name == "if" {
+		if len(n.args) != 3 {
+			return nil, fmt.Errorf("function %q expects 3 arguments: %w", n.name, ErrEvaluation)
+		}
+
+		condition, err := n.args[0].eval(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if Truthy(condition) {
+			return n.args[1].eval(scope)
+		}
+
+		return n.args[2].eval(scope)
+	}
+
+	fn, ok := functions[n.name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q: %w", n.name, ErrEvaluation)
+	}
+
+	args := make([]interface{}, len(n.args))
+
+	for i, arg := range n.args {
+		v, err := arg.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		args[i] = v
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, fmt.Errorf("function %q: unexpected number of arguments %d: %w",
+			n.name, len(args), ErrEvaluation)
+	}
+
+	v, err := fn.call(args)
+	if err != nil {
+		return nil, fmt.Errorf("function %q: %w", n.name, err)
+	}
+
+	return v, nil
+}
+
+// typeName returns the name of the type of the given value for use in
+// error messages.
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case undefined:
+		return "undefined"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func toNumber(v interface{}) (float64, error) {
+	if f, ok := v.(float64); ok {
+		return f, nil
+	}
+
+	return 0, fmt.Errorf("expected number; got %s: %w", typeName(v), ErrEvaluation)
+}
+
+func equal(left interface{}, right interface{}) bool {
+	if IsUndefined(left) {
+		left = nil
+	}
+
+	if IsUndefined(right) {
+		right = nil
+	}
+
+	return reflect.DeepEqual(left, right)
+}
+
+func compare(op string, left interface{}, right interface{}) (interface{}, error) {
+	var cmp int
+
+	switch l := left.(type) {
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare string with %s: %w", typeName(right), ErrEvaluation)
+		}
+
+		cmp = strings.Compare(l, r)
+
+	default:
+		lf, err := toNumber(left)
+		if err != nil {
+			return nil, err
+		}
+
+		rf, err := toNumber(right)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case lf < rf:
+			cmp = -1
+		case lf > rf:
+			cmp = 1
+		}
+	}
+
+	switch op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func arithmetic(op string, left interface{}, right interface{}) (interface{}, error) {
+	l, err := toNumber(left)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := toNumber(right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	}
+
+	if r == 0 {
+		return nil, fmt.Errorf("division by zero: %w", ErrEvaluation)
+	}
+
+	if op == "/" {
+		return l / r, nil
+	}
+
+	return math.Mod(l, r), nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package expression
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(`{
+		"title": "Disk usage",
+		"host": {"name": "host01", "addresses": ["10.0.0.1", "10.0.0.2"]},
+		"usage": 91.5,
+		"threshold": 90,
+		"tags": [],
+		"checked": "2026-10-18T07:05:09.25Z"
+	}`), &data); err != nil {
+		t.Fatalf("unexpected error decoding data: %v", err)
+	}
+
+	scope := Scope{Data: data, Root: data, Index: 2, HasIndex: true}
+
+	tests := []struct {
+		expr string
+		want interface{}
+	}{
+		{expr: "title", want: "Disk usage"},
+		{expr: "host.addresses[1]", want: "10.0.0.2"},
+		{expr: "$root['host'].name", want: "host01"},
+		{expr: "$index + 1", want: float64(3)},
+		{expr: "missing.property", want: Undefined},
+		{expr: "usage > threshold && !empty(host.name)", want: true},
+		{expr: "usage < threshold || exists(missing)", want: false},
+		{expr: "1 + 2 * 3 - -4 % 3", want: float64(8)},
+		{expr: "(1 + 2) * 3 == 9", want: true},
+		{expr: "'host: ' + host.name", want: "host: host01"},
+		{expr: "if(usage >= threshold, 'attention', 'good')", want: "attention"},
+		{expr: "if(false, substring(missing, 0), 'guarded')", want: "guarded"},
+		{expr: "concat(toUpper(host.name), '/', count(host.addresses))", want: "HOST01/2"},
+		{expr: "join(host.addresses, ', ')", want: "10.0.0.1, 10.0.0.2"},
+		{expr: "empty(tags) && equals(first(host.addresses), '10.0.0.1')", want: true},
+		{expr: "formatNumber(usage * 1000, 2)", want: "91,500.00"},
+		{expr: "formatDateTime(checked, 'dd MMM yyyy HH:mm:ss.fff')", want: "18 Oct 2026 07:05:09.250"},
+		{expr: "substring(title, 0, 4) == \"Disk\"", want: true},
+		{expr: "coalesce(missing, null, threshold)", want: float64(90)},
+		{expr: "max(host.addresses[5], 1) == 1", want: nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Evaluate(tt.expr, scope)
+
+			if tt.want == nil {
+				if !errors.Is(err, ErrEvaluation) {
+					t.Errorf("got %v, %v; want error %v", got, err, ErrEvaluation)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v; want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalidSyntax(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"a +",
+		"(a",
+		"a.",
+		"'unterminated",
+		"a # b",
+		"f(a b)",
+	} {
+		if _, err := Parse(expr); !errors.Is(err, ErrSyntax) {
+			t.Errorf("got error %v for %q; want %v", err, expr, ErrSyntax)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{value: nil, want: ""},
+		{value: Undefined, want: ""},
+		{value: float64(3), want: "3"},
+		{value: 0.25, want: "0.25"},
+		{value: true, want: "true"},
+		{value: []interface{}{"a", float64(1)}, want: `["a",1]`},
+	}
+
+	for _, tt := range tests {
+		if got := String(tt.value); got != tt.want {
+			t.Errorf("got %q for %#v; want %q", got, tt.value, tt.want)
+		}
+	}
+}
@@ -0,0 +1,648 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// function is a prebuilt function. A negative maxArgs indicates a variable
+// number of arguments.
+type function struct {
+	minArgs int
+	maxArgs int
+	call    func(args []interface{}) (interface{}, error)
+}
+
+// functions holds the prebuilt functions, keyed by name. The if function is
+// handled by callNode as its arguments are evaluated lazily.
+var functions = map[string]function{
+	// Logical comparison functions.
+	"equals":          {2, 2, func(args []interface{}) (interface{}, error) { return equal(args[0], args[1]), nil }},
+	"not":             {1, 1, func(args []interface{}) (interface{}, error) { return !Truthy(args[0]), nil }},
+	"and":             {1, -1, fnAnd},
+	"or":              {1, -1, fnOr},
+	"exists":          {1, 1, func(args []interface{}) (interface{}, error) { return args[0] != nil && !IsUndefined(args[0]), nil }},
+	"empty":           {1, 1, fnEmpty},
+	"greater":         {2, 2, func(args []interface{}) (interface{}, error) { return compare(">", args[0], args[1]) }},
+	"greaterOrEquals": {2, 2, func(args []interface{}) (interface{}, error) { return compare(">=", args[0], args[1]) }},
+	"less":            {2, 2, func(args []interface{}) (interface{}, error) { return compare("<", args[0], args[1]) }},
+	"lessOrEquals":    {2, 2, func(args []interface{}) (interface{}, error) { return compare("<=", args[0], args[1]) }},
+
+	// Math functions.
+	"add":     {2, 2, func(args []interface{}) (interface{}, error) { return arithmetic("+", args[0], args[1]) }},
+	"sub":     {2, 2, func(args []interface{}) (interface{}, error) { return arithmetic("-", args[0], args[1]) }},
+	"mul":     {2, 2, func(args []interface{}) (interface{}, error) { return arithmetic("*", args[0], args[1]) }},
+	"div":     {2, 2, func(args []interface{}) (interface{}, error) { return arithmetic("/", args[0], args[1]) }},
+	"mod":     {2, 2, func(args []interface{}) (interface{}, error) { return arithmetic("%", args[0], args[1]) }},
+	"min":     {1, -1, func(args []interface{}) (interface{}, error) { return extremum(args, -1) }},
+	"max":     {1, -1, func(args []interface{}) (interface{}, error) { return extremum(args, 1) }},
+	"abs":     {1, 1, mathFunc(math.Abs)},
+	"floor":   {1, 1, mathFunc(math.Floor)},
+	"ceiling": {1, 1, mathFunc(math.Ceil)},
+	"round":   {1, 2, fnRound},
+
+	// String functions.
+	"concat":     {1, -1, fnConcat},
+	"toUpper":    {1, 1, stringFunc(strings.ToUpper)},
+	"toLower":    {1, 1, stringFunc(strings.ToLower)},
+	"trim":       {1, 1, stringFunc(strings.TrimSpace)},
+	"substring":  {2, 3, fnSubstring},
+	"replace":    {3, 3, fnReplace},
+	"split":      {1, 2, fnSplit},
+	"startsWith": {2, 2, fnStartsWith},
+	"endsWith":   {2, 2, fnEndsWith},
+	"indexOf":    {2, 2, fnIndexOf},
+
+	// Collection functions.
+	"length":   {1, 1, fnCount},
+	"count":    {1, 1, fnCount},
+	"contains": {2, 2, fnContains},
+	"first":    {1, 1, func(args []interface{}) (interface{}, error) { return item(args[0], 0) }},
+	"last":     {1, 1, func(args []interface{}) (interface{}, error) { return item(args[0], -1) }},
+	"join":     {2, 2, fnJoin},
+	"coalesce": {1, -1, fnCoalesce},
+
+	// Conversion functions.
+	"string": {1, 1, func(args []interface{}) (interface{}, error) { return String(args[0]), nil }},
+	"int":    {1, 1, fnInt},
+	"float":  {1, 1, fnFloat},
+	"bool":   {1, 1, func(args []interface{}) (interface{}, error) { return Truthy(args[0]), nil }},
+	"json":   {1, 1, fnJSON},
+
+	// Formatting functions.
+	"formatNumber":   {2, 2, fnFormatNumber},
+	"formatDateTime": {1, 2, fnFormatDateTime},
+}
+
+// Functions returns the sorted names of the prebuilt functions.
+func Functions() []string {
+	names := []string{"if"}
+	for name := range functions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func toString(v interface{}) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+
+	return "", fmt.Errorf("expected string; got %s: %w", typeName(v), ErrEvaluation)
+}
+
+func toInt(v interface{}) (int, error) {
+	f, err := toNumber(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("expected integer; got %v: %w", f, ErrEvaluation)
+	}
+
+	return int(f), nil
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
+
+func mathFunc(fn func(float64) float64) func([]interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		f, err := toNumber(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(f), nil
+	}
+}
+
+func stringFunc(fn func(string) string) func([]interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		s, err := toString(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(s), nil
+	}
+}
+
+func fnAnd(args []interface{}) (interface{}, error) {
+	for _, arg := range args {
+		if !Truthy(arg) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func fnOr(args []interface{}) (interface{}, error) {
+	for _, arg := range args {
+		if Truthy(arg) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func fnEmpty(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case nil, undefined:
+		return true, nil
+	case string:
+		return v == "", nil
+	case []interface{}:
+		return len(v) == 0, nil
+	case map[string]interface{}:
+		return len(v) == 0, nil
+	default:
+		return false, nil
+	}
+}
+
+func extremum(args []interface{}, sign float64) (interface{}, error) {
+	// A single array argument provides the values.
+	if values, ok := args[0].([]interface{}); ok && len(args) == 1 {
+		args = values
+	}
+
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no values given: %w", ErrEvaluation)
+	}
+
+	result, err := toNumber(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, arg := range args[1:] {
+		f, err := toNumber(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if (f-result)*sign > 0 {
+			result = f
+		}
+	}
+
+	return result, nil
+}
+
+func fnRound(args []interface{}) (interface{}, error) {
+	f, err := toNumber(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	precision := 0
+	if len(args) == 2 {
+		if precision, err = toInt(args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	scale := math.Pow(10, float64(precision))
+
+	return math.Round(f*scale) / scale, nil
+}
+
+func fnConcat(args []interface{}) (interface{}, error) {
+	// Concatenation of arrays produces an array.
+	if _, ok := args[0].([]interface{}); ok {
+		var result []interface{}
+
+		for _, arg := range args {
+			values, ok := arg.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected array; got %s: %w", typeName(arg), ErrEvaluation)
+			}
+
+			result = append(result, values...)
+		}
+
+		return result, nil
+	}
+
+	var b strings.Builder
+	for _, arg := range args {
+		b.WriteString(String(arg))
+	}
+
+	return b.String(), nil
+}
+
+func fnSubstring(args []interface{}) (interface{}, error) {
+	s, err := toString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	runes := []rune(s)
+
+	start, err := toInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	length := len(runes) - start
+	if len(args) == 3 {
+		if length, err = toInt(args[2]); err != nil {
+			return nil, err
+		}
+	}
+
+	if start < 0 || length < 0 || start+length > len(runes) {
+		return nil, fmt.Errorf("index out of range: %w", ErrEvaluation)
+	}
+
+	return string(runes[start : start+length]), nil
+}
+
+func fnReplace(args []interface{}) (interface{}, error) {
+	var s [3]string
+
+	for i := range s {
+		v, err := toString(args[i])
+		if err != nil {
+			return nil, err
+		}
+
+		s[i] = v
+	}
+
+	return strings.ReplaceAll(s[0], s[1], s[2]), nil
+}
+
+func fnSplit(args []interface{}) (interface{}, error) {
+	s, err := toString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	separator := ""
+	if len(args) == 2 {
+		if separator, err = toString(args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	parts := strings.Split(s, separator)
+
+	result := make([]interface{}, len(parts))
+	for i, part := range parts {
+		result[i] = part
+	}
+
+	return result, nil
+}
+
+func stringPair(args []interface{}) (string, string, error) {
+	s, err := toString(args[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	sub, err := toString(args[1])
+	if err != nil {
+		return "", "", err
+	}
+
+	return s, sub, nil
+}
+
+func fnStartsWith(args []interface{}) (interface{}, error) {
+	s, prefix, err := stringPair(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.HasPrefix(s, prefix), nil
+}
+
+func fnEndsWith(args []interface{}) (interface{}, error) {
+	s, suffix, err := stringPair(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.HasSuffix(s, suffix), nil
+}
+
+func fnIndexOf(args []interface{}) (interface{}, error) {
+	if values, ok := args[0].([]interface{}); ok {
+		for i, v := range values {
+			if equal(v, args[1]) {
+				return float64(i), nil
+			}
+		}
+
+		return float64(-1), nil
+	}
+
+	s, sub, err := stringPair(args)
+	if err != nil {
+		return nil, err
+	}
+
+	i := strings.Index(s, sub)
+	if i < 0 {
+		return float64(-1), nil
+	}
+
+	return float64(len([]rune(s[:i]))), nil
+}
+
+func fnCount(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case string:
+		return float64(len([]rune(v))), nil
+	case []interface{}:
+		return float64(len(v)), nil
+	case map[string]interface{}:
+		return float64(len(v)), nil
+	default:
+		return nil, fmt.Errorf("expected string, array or object; got %s: %w", typeName(v), ErrEvaluation)
+	}
+}
+
+func fnContains(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if equal(item, args[1]) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+
+	case map[string]interface{}:
+		name, err := toString(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		_, ok := v[name]
+
+		return ok, nil
+
+	default:
+		s, sub, err := stringPair(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return strings.Contains(s, sub), nil
+	}
+}
+
+// item returns the array item or character at the given index; a negative
+// index counts from the end. Undefined is returned for an empty array or
+// string.
+func item(v interface{}, index int) (interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return Undefined, nil
+		}
+
+		if index < 0 {
+			index += len(v)
+		}
+
+		return v[index], nil
+
+	case string:
+		runes := []rune(v)
+		if len(runes) == 0 {
+			return Undefined, nil
+		}
+
+		if index < 0 {
+			index += len(runes)
+		}
+
+		return string(runes[index]), nil
+
+	default:
+		return nil, fmt.Errorf("expected array or string; got %s: %w", typeName(v), ErrEvaluation)
+	}
+}
+
+func fnJoin(args []interface{}) (interface{}, error) {
+	values, ok := args[0].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected array; got %s: %w", typeName(args[0]), ErrEvaluation)
+	}
+
+	separator, err := toString(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = String(v)
+	}
+
+	return strings.Join(parts, separator), nil
+}
+
+func fnCoalesce(args []interface{}) (interface{}, error) {
+	for _, arg := range args {
+		if arg != nil && !IsUndefined(arg) {
+			return arg, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func fnInt(args []interface{}) (interface{}, error) {
+	f, err := fnFloat(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return math.Trunc(f.(float64)), nil
+}
+
+func fnFloat(args []interface{}) (interface{}, error) {
+	switch v := args[0].(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", v, ErrEvaluation)
+		}
+
+		return f, nil
+	default:
+		return nil, fmt.Errorf("expected number or string; got %s: %w", typeName(v), ErrEvaluation)
+	}
+}
+
+func fnJSON(args []interface{}) (interface{}, error) {
+	s, err := toString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrEvaluation)
+	}
+
+	return v, nil
+}
+
+// fnFormatNumber formats the given number with the given number of
+// decimals and a comma as thousands separator (e.g., "1,234.50").
+func fnFormatNumber(args []interface{}) (interface{}, error) {
+	f, err := toNumber(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := toInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if decimals < 0 {
+		return nil, fmt.Errorf("invalid number of decimals %d: %w", decimals, ErrEvaluation)
+	}
+
+	s := strconv.FormatFloat(math.Abs(f), 'f', decimals, 64)
+
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		integer, fraction = s[:i], s[i:]
+	}
+
+	var b strings.Builder
+
+	if f < 0 && strings.Trim(s, "0.") != "" {
+		b.WriteByte('-')
+	}
+
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteRune(digit)
+	}
+
+	b.WriteString(fraction)
+
+	return b.String(), nil
+}
+
+// dateTimeLayouts maps the supported custom date and time format
+// specifiers to the equivalent layout of the time package, longest first.
+var dateTimeLayouts = []struct {
+	specifier string
+	layout    string
+}{
+	{"yyyy", "2006"},
+	{"yy", "06"},
+	{"MMMM", "January"},
+	{"MMM", "Jan"},
+	{"MM", "01"},
+	{"M", "1"},
+	{"dddd", "Monday"},
+	{"ddd", "Mon"},
+	{"dd", "02"},
+	{"d", "2"},
+	{"HH", "15"},
+	{"hh", "03"},
+	{"h", "3"},
+	{"mm", "04"},
+	{"m", "4"},
+	{"ss", "05"},
+	{"s", "5"},
+	{"fff", "000"},
+	{"tt", "PM"},
+}
+
+// fnFormatDateTime formats the given RFC 3339 timestamp using the given
+// custom date and time format (e.g., "yyyy-MM-dd HH:mm"), which defaults to
+// "yyyy-MM-ddTHH:mm:ss.fffZ". Timestamps are formatted in UTC.
+func fnFormatDateTime(args []interface{}) (interface{}, error) {
+	s, err := toString(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %q: %w", s, ErrEvaluation)
+	}
+
+	format := "yyyy-MM-ddTHH:mm:ss.fffZ"
+	if len(args) == 2 {
+		if format, err = toString(args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	t = t.UTC()
+
+	var b strings.Builder
+
+	for format != "" {
+		matched := false
+
+		for _, l := range dateTimeLayouts {
+			if strings.HasPrefix(format, l.specifier) {
+				if l.specifier == "fff" {
+					// The time package requires fractional seconds to
+					// follow a period.
+					fmt.Fprintf(&b, "%03d", t.Nanosecond()/int(time.Millisecond))
+				} else {
+					b.WriteString(t.Format(l.layout))
+				}
+
+				format = format[len(l.specifier):]
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			b.WriteByte(format[0])
+			format = format[1:]
+		}
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+Package jsonenc provides JSON encoding and buffer helpers backed by pools of
+reusable values. The goal is to reduce allocations for clients which prepare
+and submit a high volume of messages. Append writes the encoding to a caller
+supplied slice, which allows callers to reuse their output buffer.
+
+Unlike json.Marshal, HTML escaping of the characters <, > and & can be
+disabled. This keeps the <at></at> tags used by user mentions readable and
+results in smaller payloads.
+*/
+package jsonenc
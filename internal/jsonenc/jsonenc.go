@@ -0,0 +1,128 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package jsonenc
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+)
+
+// MaxPooledBufferSize is the largest buffer capacity retained for reuse by
+// PutBuffer. Larger buffers are discarded so that a single oversized payload does not
+// pin memory for the life of the process.
+const MaxPooledBufferSize int = 64 * 1024
+
+// appendWriter is an io.Writer which appends to a caller supplied slice.
+type appendWriter struct {
+	buf []byte
+}
+
+// Write implements the io.Writer interface.
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	return len(p), nil
+}
+
+// encoder pairs a json.Encoder with the writer it writes to so that both can
+// be reused.
+type encoder struct {
+	w   *appendWriter
+	enc *json.Encoder
+}
+
+// encoderPools holds reusable encoders; encoders with HTML escaping enabled
+// are pooled at index 1. Changing the escaping setting of a json.Encoder
+// allocates, so each pool keeps a fixed setting.
+var encoderPools = [2]sync.Pool{
+	{New: func() interface{} { return newEncoder(false) }},
+	{New: func() interface{} { return newEncoder(true) }},
+}
+
+// newEncoder returns a new encoder with the given HTML escaping setting.
+func newEncoder(escapeHTML bool) *encoder {
+	w := &appendWriter{}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(escapeHTML)
+
+	return &encoder{
+		w:   w,
+		enc: enc,
+	}
+}
+
+// encoderPool returns the pool of encoders with the given HTML escaping
+// setting.
+func encoderPool(escapeHTML bool) *sync.Pool {
+	if escapeHTML {
+		return &encoderPools[1]
+	}
+
+	return &encoderPools[0]
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+// GetBuffer returns an empty buffer from the pool. The caller should return
+// the buffer using PutBuffer once it is no longer needed.
+func GetBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	return buf
+}
+
+// PutBuffer returns the given buffer to the pool. The buffer must not be used
+// after calling this function.
+func PutBuffer(buf *bytes.Buffer) {
+	if buf == nil || buf.Cap() > MaxPooledBufferSize {
+		return
+	}
+
+	bufferPool.Put(buf)
+}
+
+// Marshal returns the JSON encoding of v. If escapeHTML is false, the
+// characters <, > and & are not escaped. The returned slice is owned by the
+// caller; no pooled memory is shared.
+func Marshal(v interface{}, escapeHTML bool) ([]byte, error) {
+	return Append(nil, v, escapeHTML)
+}
+
+// Append appends the JSON encoding of v to dst and returns the extended
+// slice. If escapeHTML is false, the characters <, > and & are not escaped.
+// The encoding is written to dst directly, so callers which reuse dst (e.g.,
+// dst[:0] of a previous result) avoid allocating a new slice per call. dst is
+// returned unchanged if an error occurs.
+func Append(dst []byte, v interface{}, escapeHTML bool) ([]byte, error) {
+	pool := encoderPool(escapeHTML)
+
+	e := pool.Get().(*encoder)
+	defer pool.Put(e)
+
+	e.w.buf = dst
+	err := e.enc.Encode(v)
+	out := e.w.buf
+
+	// The pooled encoder must not retain memory owned by the caller.
+	e.w.buf = nil
+
+	if err != nil {
+		return dst, err
+	}
+
+	// Drop the trailing newline added by json.Encoder for consistency with
+	// json.Marshal output.
+	return out[:len(out)-1], nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package jsonenc
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type benchPayload struct {
+	Type     string            `json:"type"`
+	Text     string            `json:"text"`
+	Entities []benchEntity     `json:"entities"`
+	Extra    map[string]string `json:"extra"`
+}
+
+type benchEntity struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+	ID   string `json:"id"`
+}
+
+func newBenchPayload() benchPayload {
+	entities := make([]benchEntity, 10)
+	for i := range entities {
+		entities[i] = benchEntity{
+			Type: "mention",
+			Text: "<at>John Doe</at>",
+			ID:   "5e8b0f4d-2cd4-4e17-9467-b0f6a5c0c4d0",
+		}
+	}
+
+	return benchPayload{
+		Type:     "message",
+		Text:     "<at>John Doe</at> the build & deploy pipeline failed",
+		Entities: entities,
+		Extra:    map[string]string{"severity": "critical"},
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	payload := newBenchPayload()
+
+	expected, err := json.Marshal(payload)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	got, err := Marshal(payload, true)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	got, err = Marshal(payload, false)
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "<at>John Doe</at>")
+	assert.Less(t, len(got), len(expected))
+}
+
+func TestAppend(t *testing.T) {
+	payload := newBenchPayload()
+
+	expected, err := json.Marshal(payload)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	got, err := Append([]byte("["), payload, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "["+string(expected), string(got))
+
+	// The output buffer is reused once its capacity suffices.
+	reused, err := Append(got[:0], payload, true)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, reused)
+	assert.Equal(t, &got[0], &reused[0])
+
+	dst := []byte("[")
+	got, err = Append(dst, func() {}, false)
+	assert.Error(t, err)
+	assert.Equal(t, dst, got)
+}
+
+// BenchmarkMarshalStdlib measures encoding using json.Marshal with the
+// default HTML escaping.
+func BenchmarkMarshalStdlib(b *testing.B) {
+	payload := newBenchPayload()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkMarshalUnpooledEncoder measures encoding with HTML escaping
+// disabled using a new json.Encoder and buffer for each payload.
+func BenchmarkMarshalUnpooledEncoder(b *testing.B) {
+	payload := newBenchPayload()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+
+		if err := enc.Encode(payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkAppendPooled measures encoding with HTML escaping disabled using
+// a pooled encoder which appends to a reused output buffer.
+func BenchmarkAppendPooled(b *testing.B) {
+	payload := newBenchPayload()
+
+	var buf []byte
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var err error
+
+		buf, err = Append(buf[:0], payload, false)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+Package jsonschema provides offline validation of JSON documents against a
+JSON Schema (draft-07). Only the subset of keywords used by the Adaptive Card
+schema is supported:
+
+  - $ref (local references only, e.g., "#/definitions/TextBlock")
+  - type, enum, const, pattern, minimum and maximum
+  - properties, required and additionalProperties
+  - items and minItems
+  - anyOf and allOf
+
+Unsupported keywords are ignored.
+
+The anyOf keyword is evaluated using the JSON type of the value and, for
+objects, the value of the "type" property as a discriminator. This allows a
+violation within a card element to be reported for the element type instead
+of as a mismatch against every element type permitted by the schema.
+*/
+package jsonschema
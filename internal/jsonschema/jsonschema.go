@@ -0,0 +1,609 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidSchema indicates that a schema document could not be parsed or
+// contains an unresolvable reference or invalid pattern.
+var ErrInvalidSchema = errors.New("invalid schema")
+
+// refPrefix is the prefix of supported local references.
+const refPrefix string = "#/"
+
+// Schema is a parsed JSON Schema document.
+type Schema struct {
+	root     map[string]interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+// Violation is a failed schema assertion.
+type Violation struct {
+	// Path is the JSON Pointer (RFC 6901) location of the offending value
+	// (e.g., "/body/0/size").
+	Path string
+
+	// Keyword is the schema keyword which failed (e.g., "enum").
+	Keyword string
+
+	// Message describes the violation.
+	Message string
+}
+
+// String returns the path and message of the violation.
+func (v Violation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+
+	return v.Path + ": " + v.Message
+}
+
+// Decode decodes the given JSON document for validation. Numbers are
+// decoded as json.Number values.
+func Decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Parse parses the given JSON Schema document.
+func Parse(doc []byte) (*Schema, error) {
+	v, err := Decode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidSchema)
+	}
+
+	root, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema document is not an object: %w", ErrInvalidSchema)
+	}
+
+	return New(root)
+}
+
+// New creates a Schema from the given decoded JSON Schema document (see
+// Decode). The document is not copied and must not be modified afterwards.
+func New(root map[string]interface{}) (*Schema, error) {
+	s := Schema{
+		root:     root,
+		patterns: make(map[string]*regexp.Regexp),
+	}
+
+	if err := s.compile(root); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// compile asserts that all references of the given schema can be resolved
+// and compiles all patterns.
+func (s *Schema) compile(schema interface{}) error {
+	switch v := schema.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			if _, err := s.lookup(ref); err != nil {
+				return err
+			}
+		}
+
+		if pattern, ok := v["pattern"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return fmt.Errorf("pattern %q: %v: %w", pattern, err, ErrInvalidSchema)
+			}
+
+			s.patterns[pattern] = re
+		}
+
+		for key, child := range v {
+			// Enum and const values are data, not schemas.
+			if key == "enum" || key == "const" {
+				continue
+			}
+
+			if err := s.compile(child); err != nil {
+				return err
+			}
+		}
+
+	case []interface{}:
+		for _, child := range v {
+			if err := s.compile(child); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookup resolves the given local reference (e.g., "#/definitions/Image").
+func (s *Schema) lookup(ref string) (interface{}, error) {
+	if ref == "#" {
+		return s.root, nil
+	}
+
+	if !strings.HasPrefix(ref, refPrefix) {
+		return nil, fmt.Errorf("unsupported reference %q: %w", ref, ErrInvalidSchema)
+	}
+
+	var current interface{} = s.root
+
+	for _, token := range strings.Split(strings.TrimPrefix(ref, refPrefix), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unresolvable reference %q: %w", ref, ErrInvalidSchema)
+		}
+
+		if current, ok = obj[token]; !ok {
+			return nil, fmt.Errorf("unresolvable reference %q: %w", ref, ErrInvalidSchema)
+		}
+	}
+
+	return current, nil
+}
+
+// Validate validates the given decoded value (see Decode) against the root
+// of the schema and returns all violations found.
+func (s *Schema) Validate(instance interface{}) []Violation {
+	return s.validate(s.root, instance, "")
+}
+
+// ValidateRef validates the given decoded value (see Decode) located at the
+// given path against the schema referenced by ref (e.g.,
+// "#/definitions/AdaptiveCard") and returns all violations found. Violation
+// paths are prefixed with the given path.
+func (s *Schema) ValidateRef(ref string, instance interface{}, path string) ([]Violation, error) {
+	schema, err := s.lookup(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(schema, instance, path), nil
+}
+
+// resolve follows $ref keywords until a schema without reference is found.
+// References are asserted to be resolvable when the Schema is created.
+func (s *Schema) resolve(schema interface{}) interface{} {
+	for i := 0; i < 32; i++ {
+		obj, ok := schema.(map[string]interface{})
+		if !ok {
+			return schema
+		}
+
+		ref, ok := obj["$ref"].(string)
+		if !ok {
+			return schema
+		}
+
+		schema, _ = s.lookup(ref)
+	}
+
+	return schema
+}
+
+// validate validates the given value located at the given path against the
+// given schema.
+func (s *Schema) validate(schema interface{}, instance interface{}, path string) []Violation {
+	schema = s.resolve(schema)
+
+	switch v := schema.(type) {
+	case bool:
+		if !v {
+			return []Violation{{Path: path, Keyword: "false", Message: "value is not allowed"}}
+		}
+
+		return nil
+
+	case map[string]interface{}:
+		return s.validateObject(v, instance, path)
+
+	default:
+		return nil
+	}
+}
+
+// validateObject validates the given value against the keywords of the
+// given schema object.
+func (s *Schema) validateObject(schema map[string]interface{}, instance interface{}, path string) []Violation {
+	// A type mismatch makes all other assertions meaningless.
+	if types := schemaTypes(schema); len(types) > 0 && !typeInList(instance, types) {
+		return []Violation{{
+			Path:    path,
+			Keyword: "type",
+			Message: fmt.Sprintf("expected %s; got %s", strings.Join(types, " or "), jsonType(instance)),
+		}}
+	}
+
+	var violations []Violation
+
+	add := func(keyword string, format string, a ...interface{}) {
+		violations = append(violations, Violation{
+			Path:    path,
+			Keyword: keyword,
+			Message: fmt.Sprintf(format, a...),
+		})
+	}
+
+	if expected, ok := schema["const"]; ok && !equal(instance, expected) {
+		add("const", "expected %s; got %s", render(expected), render(instance))
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !inEnum(instance, enum) {
+		add("enum", "value %s is not one of %s", render(instance), render(enum))
+	}
+
+	switch value := instance.(type) {
+	case string:
+		if pattern, ok := schema["pattern"].(string); ok && !s.patterns[pattern].MatchString(value) {
+			add("pattern", "value %q does not match pattern %q", value, pattern)
+		}
+
+	case json.Number:
+		if minimum, ok := schema["minimum"].(json.Number); ok && compareNumbers(value, minimum) < 0 {
+			add("minimum", "value %s is less than minimum %s", value, minimum)
+		}
+
+		if maximum, ok := schema["maximum"].(json.Number); ok && compareNumbers(value, maximum) > 0 {
+			add("maximum", "value %s is greater than maximum %s", value, maximum)
+		}
+
+	case []interface{}:
+		if minItems, ok := schema["minItems"].(json.Number); ok {
+			if n, err := minItems.Int64(); err == nil && int64(len(value)) < n {
+				add("minItems", "expected at least %d items; got %d", n, len(value))
+			}
+		}
+
+		if items, ok := schema["items"]; ok {
+			for i, item := range value {
+				violations = append(violations, s.validate(items, item, fmt.Sprintf("%s/%d", path, i))...)
+			}
+		}
+
+	case map[string]interface{}:
+		violations = append(violations, s.validateProperties(schema, value, path)...)
+	}
+
+	if allOf, ok := schema["allOf"].([]interface{}); ok {
+		for _, branch := range allOf {
+			violations = append(violations, s.validate(branch, instance, path)...)
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		violations = append(violations, s.validateAnyOf(anyOf, instance, path)...)
+	}
+
+	return violations
+}
+
+// validateProperties validates the properties of the given object against
+// the required, properties and additionalProperties keywords of the given
+// schema.
+func (s *Schema) validateProperties(schema map[string]interface{}, obj map[string]interface{}, path string) []Violation {
+	var violations []Violation
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			name, _ := name.(string)
+			if _, ok := obj[name]; !ok {
+				violations = append(violations, Violation{
+					Path:    path,
+					Keyword: "required",
+					Message: fmt.Sprintf("required property %q is missing", name),
+				})
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	additional, hasAdditional := schema["additionalProperties"]
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		propertyPath := path + "/" + escapePointerToken(key)
+
+		if property, ok := properties[key]; ok {
+			violations = append(violations, s.validate(property, obj[key], propertyPath)...)
+
+			continue
+		}
+
+		if !hasAdditional {
+			continue
+		}
+
+		if allowed, ok := additional.(bool); ok {
+			if !allowed {
+				violations = append(violations, Violation{
+					Path:    propertyPath,
+					Keyword: "additionalProperties",
+					Message: fmt.Sprintf("property %q is not allowed", key),
+				})
+			}
+
+			continue
+		}
+
+		violations = append(violations, s.validate(additional, obj[key], propertyPath)...)
+	}
+
+	return violations
+}
+
+// validateAnyOf validates the given value against the branches of an anyOf
+// keyword. Branches are selected using the JSON type of the value and the
+// "type" property of objects; the violations of the selected branch are
+// reported.
+func (s *Schema) validateAnyOf(branches []interface{}, instance interface{}, path string) []Violation {
+	var candidates []interface{}
+
+	for _, branch := range branches {
+		resolved, _ := s.resolve(branch).(map[string]interface{})
+		if resolved == nil || s.branchMatches(resolved, instance) {
+			candidates = append(candidates, branch)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return []Violation{{Path: path, Keyword: "anyOf", Message: s.noMatchMessage(branches, instance)}}
+	}
+
+	var first []Violation
+
+	for i, candidate := range candidates {
+		violations := s.validate(candidate, instance, path)
+		if len(violations) == 0 {
+			return nil
+		}
+
+		if i == 0 {
+			first = violations
+		}
+	}
+
+	return first
+}
+
+// branchMatches indicates whether the given resolved anyOf branch applies to
+// the given value based on its JSON type and discriminator.
+func (s *Schema) branchMatches(branch map[string]interface{}, instance interface{}) bool {
+	if types := schemaTypes(branch); len(types) > 0 && !typeInList(instance, types) {
+		return false
+	}
+
+	if expected, ok := branch["const"]; ok && jsonType(expected) != jsonType(instance) {
+		return false
+	}
+
+	discriminator, ok := s.discriminator(branch)
+	if !ok {
+		return true
+	}
+
+	obj, ok := instance.(map[string]interface{})
+	if !ok {
+		return true
+	}
+
+	typeName, ok := obj["type"].(string)
+	if !ok {
+		return true
+	}
+
+	return inEnum(typeName, discriminator)
+}
+
+// discriminator returns the values permitted for the "type" property by the
+// given resolved schema, if restricted by a const or enum keyword.
+func (s *Schema) discriminator(schema map[string]interface{}) ([]interface{}, bool) {
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	typeSchema, _ := s.resolve(properties["type"]).(map[string]interface{})
+	if typeSchema == nil {
+		return nil, false
+	}
+
+	if expected, ok := typeSchema["const"]; ok {
+		return []interface{}{expected}, true
+	}
+
+	if enum, ok := typeSchema["enum"].([]interface{}); ok {
+		return enum, true
+	}
+
+	return nil, false
+}
+
+// noMatchMessage describes a value which matches none of the given anyOf
+// branches.
+func (s *Schema) noMatchMessage(branches []interface{}, instance interface{}) string {
+	var discriminators []interface{}
+	var types []string
+
+	for _, branch := range branches {
+		resolved, _ := s.resolve(branch).(map[string]interface{})
+
+		if values, ok := s.discriminator(resolved); ok {
+			discriminators = append(discriminators, values...)
+		}
+
+		types = append(types, schemaTypes(resolved)...)
+	}
+
+	if obj, ok := instance.(map[string]interface{}); ok && len(discriminators) > 0 {
+		return fmt.Sprintf("unsupported type %s; expected one of %s", render(obj["type"]), render(discriminators))
+	}
+
+	if len(types) > 0 {
+		return fmt.Sprintf("expected %s; got %s", strings.Join(uniqueStrings(types), " or "), jsonType(instance))
+	}
+
+	return fmt.Sprintf("value %s does not match any allowed schema", render(instance))
+}
+
+// schemaTypes returns the JSON types permitted by the type keyword of the
+// given schema.
+func schemaTypes(schema map[string]interface{}) []string {
+	switch v := schema["type"].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		types := make([]string, 0, len(v))
+		for _, t := range v {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+
+		return types
+	default:
+		return nil
+	}
+}
+
+// jsonType returns the JSON type of the given decoded value.
+func jsonType(v interface{}) string {
+	switch n := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := n.Int64(); err == nil {
+			return "integer"
+		}
+
+		return "number"
+	case float64:
+		if n == float64(int64(n)) {
+			return "integer"
+		}
+
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// typeInList indicates whether the JSON type of the given value is one of
+// the given types. Integers are numbers.
+func typeInList(v interface{}, types []string) bool {
+	actual := jsonType(v)
+
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equal indicates whether the given decoded values are equal. Numbers are
+// compared by value.
+func equal(a interface{}, b interface{}) bool {
+	an, aok := a.(json.Number)
+	bn, bok := b.(json.Number)
+
+	if aok && bok {
+		return compareNumbers(an, bn) == 0
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// inEnum indicates whether the given value equals one of the enum values.
+func inEnum(v interface{}, enum []interface{}) bool {
+	for _, item := range enum {
+		if equal(v, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compareNumbers compares the given numbers, returning -1, 0 or +1.
+// Numbers which cannot be parsed compare equal.
+func compareNumbers(a json.Number, b json.Number) int {
+	x, ok := new(big.Float).SetString(a.String())
+	if !ok {
+		return 0
+	}
+
+	y, ok := new(big.Float).SetString(b.String())
+	if !ok {
+		return 0
+	}
+
+	return x.Cmp(y)
+}
+
+// render returns the JSON representation of the given decoded value.
+func render(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+// escapePointerToken escapes the given JSON Pointer reference token.
+func escapePointerToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// uniqueStrings returns the given values with duplicates removed.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package jsonschema
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+	"$ref": "#/definitions/Shape",
+	"definitions": {
+		"Shape": {"anyOf": [{"$ref": "#/definitions/Circle"}, {"$ref": "#/definitions/Group"}]},
+		"Circle": {
+			"type": "object",
+			"required": ["type", "radius"],
+			"properties": {
+				"type": {"const": "Circle"},
+				"radius": {"type": "number", "minimum": 0},
+				"color": {"type": "string", "enum": ["red", "green"]},
+				"label": {"anyOf": [{"type": "string", "pattern": "^[a-z]+$"}, {"type": "null"}]}
+			},
+			"additionalProperties": false
+		},
+		"Group": {
+			"type": "object",
+			"required": ["type"],
+			"properties": {
+				"type": {"const": "Group"},
+				"shapes": {"type": "array", "minItems": 1, "items": {"$ref": "#/definitions/Shape"}}
+			},
+			"additionalProperties": false
+		}
+	}
+}`
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := Parse([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("unexpected error parsing schema: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		instance string
+		want     []Violation
+	}{
+		{
+			name:     "valid",
+			instance: `{"type": "Group", "shapes": [{"type": "Circle", "radius": 1.5, "label": "a"}]}`,
+		},
+		{
+			name:     "nested violations",
+			instance: `{"type": "Group", "shapes": [{"type": "Circle", "radius": -1, "color": "blue", "size": 1}, {"type": "Square"}]}`,
+			want: []Violation{
+				{Path: "/shapes/0/color", Keyword: "enum", Message: `value "blue" is not one of ["red","green"]`},
+				{Path: "/shapes/0/radius", Keyword: "minimum", Message: "value -1 is less than minimum 0"},
+				{Path: "/shapes/0/size", Keyword: "additionalProperties", Message: `property "size" is not allowed`},
+				{Path: "/shapes/1", Keyword: "anyOf", Message: `unsupported type "Square"; expected one of ["Circle","Group"]`},
+			},
+		},
+		{
+			name:     "missing and mistyped values",
+			instance: `{"type": "Circle", "label": 7}`,
+			want: []Violation{
+				{Path: "", Keyword: "required", Message: `required property "radius" is missing`},
+				{Path: "/label", Keyword: "anyOf", Message: "expected string or null; got integer"},
+			},
+		},
+		{
+			name:     "empty array",
+			instance: `{"type": "Group", "shapes": []}`,
+			want: []Violation{
+				{Path: "/shapes", Keyword: "minItems", Message: "expected at least 1 items; got 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			instance, err := Decode([]byte(tt.instance))
+			if err != nil {
+				t.Fatalf("unexpected error decoding instance: %v", err)
+			}
+
+			got := schema.Validate(instance)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got violations %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalidSchema(t *testing.T) {
+	for _, doc := range []string{
+		`[]`,
+		`{"$ref": "#/definitions/Missing"}`,
+		`{"pattern": "("}`,
+	} {
+		if _, err := Parse([]byte(doc)); !errors.Is(err, ErrInvalidSchema) {
+			t.Errorf("got error %v for %s; want %v", err, doc, ErrInvalidSchema)
+		}
+	}
+}
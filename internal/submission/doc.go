@@ -0,0 +1,13 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+Package submission provides the message handling shared by the TeamsClient
+type and the teamstest.FakeSender test double, so that both treat submitted
+messages alike.
+*/
+package submission
@@ -0,0 +1,76 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package submission
+
+import (
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sync"
+)
+
+// Preparer is a message type that supports marshaling its fields as
+// preparation for delivery to an endpoint.
+type Preparer interface {
+	Prepare() error
+	Payload() io.Reader
+}
+
+// Validater is a message type that provides validation of its format.
+type Validater interface {
+	Validate() error
+}
+
+// mu guards the payloads recorded within messages. Validating a message may
+// copy it (e.g., adaptivecard.Message has a value receiver for Validate),
+// which must not overlap with a concurrent submission of the same message
+// recording its payload.
+var mu sync.RWMutex
+
+// IsNilMessage indicates whether the given message is nil or a nil pointer wrapped
+// in an interface.
+func IsNilMessage(message interface{}) bool {
+	if message == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(message)
+
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// Validate validates the given message. The message may be submitted by
+// other goroutines concurrently (see Payload).
+func Validate(message Validater) error {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return message.Validate()
+}
+
+// Payload prepares the given message and returns the JSON payload recorded
+// by it. The payload remains recorded within the message, e.g., for use with
+// its PrettyPrint method. A nil reader is treated as an empty payload.
+//
+// The same message may be submitted by several goroutines concurrently; the
+// payload returned is the one recorded by this call.
+func Payload(message Preparer) ([]byte, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := message.Prepare(); err != nil {
+		return nil, err
+	}
+
+	r := message.Payload()
+	if r == nil {
+		return nil, nil
+	}
+
+	return ioutil.ReadAll(r)
+}
@@ -10,6 +10,9 @@ Package validator provides logic to assist with validation tasks. The logic is
 designed so that each subsequent validation step short-circuits after the
 first validation failure; only the first validation failure is reported.
 
+Failed checks of specific field values are reported as FieldError values
+which record the field, the rule and the offending value.
+
 Credit to Fabrizio Milo for sharing the original implementation:
 
 - https://stackoverflow.com/a/23960293/903870
@@ -27,9 +27,78 @@ type Validater interface {
 // After performing a validation check, the caller is responsible for checking
 // the result to determine if further validation checks should be performed.
 //
+// If ReportAll is set, validation checks are performed even if a prior check
+// failed and all validation errors are recorded (see Errs).
+//
 // Heavily inspired by: https://stackoverflow.com/a/23960293/903870
 type Validator struct {
-	err error
+	err  error
+	errs []error
+
+	// ReportAll indicates whether validation checks are performed after a
+	// validation check failure.
+	ReportAll bool
+
+	// SkipNested indicates whether the validation of nested items (see
+	// SelfValidateNested) is skipped, e.g., because the nested items are
+	// validated separately.
+	SkipNested bool
+}
+
+// Rules identifying the validation check which failed. See FieldError.
+const (
+	RuleEquals      string = "equals"
+	RuleRequired    string = "required"
+	RuleOneOf       string = "oneOf"
+	RuleNoNilValues string = "noNilValues"
+)
+
+// FieldError describes a failed validation check of a specific field value.
+// The error message is provided by the wrapped error.
+type FieldError struct {
+	// Field describes the field value being validated (e.g., "Type").
+	Field string
+
+	// Rule identifies the validation check which failed (e.g., RuleOneOf).
+	Rule string
+
+	// Value is the offending field value, if applicable.
+	Value interface{}
+
+	// Err is the validation error.
+	Err error
+}
+
+// Error provides the message of the wrapped validation error.
+func (fe *FieldError) Error() string {
+	return fe.Err.Error()
+}
+
+// Unwrap returns the wrapped validation error.
+func (fe *FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// fieldError wraps the given validation error in a FieldError.
+func fieldError(field string, rule string, value interface{}, err error) error {
+	return &FieldError{
+		Field: field,
+		Rule:  rule,
+		Value: value,
+		Err:   err,
+	}
+}
+
+// halted indicates whether validation checks are skipped because a prior
+// validation check failed.
+func (v *Validator) halted() bool {
+	return v.err != nil && !v.ReportAll
+}
+
+// fail records the given validation error.
+func (v *Validator) fail(err error) {
+	v.err = err
+	v.errs = append(v.errs, err)
 }
 
 // hasNilValues is a helper function used to determine whether any items in
@@ -48,16 +117,34 @@ func hasNilValues(items []interface{}) bool {
 // A true value is returned if the validation step passed. A false value is
 // returned if this or a prior validation step failed.
 func (v *Validator) SelfValidate(items ...Validater) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
+	passed := true
 	for _, item := range items {
 		if err := item.Validate(); err != nil {
-			v.err = err
-			return false
+			v.fail(err)
+			passed = false
 		}
 	}
-	return true
+	return passed
+}
+
+// SelfValidateNested asserts that each given nested item can self-validate
+// (see SelfValidate) unless SkipNested is set.
+//
+// A true value is returned if the validation step passed or was skipped. A
+// false value is returned if this or a prior validation step failed.
+func (v *Validator) SelfValidateNested(items ...Validater) bool {
+	if v.halted() {
+		return false
+	}
+
+	if v.SkipNested {
+		return true
+	}
+
+	return v.SelfValidate(items...)
 }
 
 // SelfValidateIfXEqualsY asserts that each given item can self-validate if
@@ -66,7 +153,7 @@ func (v *Validator) SelfValidate(items ...Validater) bool {
 // A true value is returned if the validation step passed. A false value is
 // returned false if this or a prior validation step failed.
 func (v *Validator) SelfValidateIfXEqualsY(x string, y string, items ...Validater) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
 
@@ -93,7 +180,7 @@ func (v *Validator) FieldHasSpecificValue(
 ) bool {
 
 	switch {
-	case v.err != nil:
+	case v.halted():
 		return false
 
 	case fieldVal != reqVal:
@@ -107,6 +194,7 @@ func (v *Validator) FieldHasSpecificValue(
 			reqVal,
 			baseErr,
 		)
+		v.fail(fieldError(fieldValDesc, RuleEquals, fieldVal, v.err))
 		return false
 
 	default:
@@ -130,7 +218,7 @@ func (v *Validator) FieldHasSpecificValueIfFieldNotEmpty(
 ) bool {
 
 	switch {
-	case v.err != nil:
+	case v.halted():
 		return false
 
 	case fieldVal != "":
@@ -155,7 +243,7 @@ func (v *Validator) FieldHasSpecificValueIfFieldNotEmpty(
 // A true value is returned if the validation step passed. A false value is
 // returned if this or a prior validation step failed.
 func (v *Validator) NotEmptyValue(fieldVal string, fieldValDesc string, typeDesc string, baseErr error) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
 	if fieldVal == "" {
@@ -165,6 +253,7 @@ func (v *Validator) NotEmptyValue(fieldVal string, fieldValDesc string, typeDesc
 			typeDesc,
 			baseErr,
 		)
+		v.fail(fieldError(fieldValDesc, RuleRequired, fieldVal, v.err))
 		return false
 	}
 	return true
@@ -184,7 +273,7 @@ func (v *Validator) NotEmptyValue(fieldVal string, fieldValDesc string, typeDesc
 //   - the validVals collection to compare against is empty
 func (v *Validator) InList(fieldVal string, fieldValDesc string, typeDesc string, validVals []string, baseErr error) bool {
 	switch {
-	case v.err != nil:
+	case v.halted():
 		return false
 
 	case fieldVal == "":
@@ -226,6 +315,7 @@ func (v *Validator) InList(fieldVal string, fieldValDesc string, typeDesc string
 			)
 		}
 
+		v.fail(fieldError(fieldValDesc, RuleOneOf, fieldVal, v.err))
 		return false
 
 	// Validation is good.
@@ -247,7 +337,7 @@ func (v *Validator) InList(fieldVal string, fieldValDesc string, typeDesc string
 //   - the validVals collection to compare against is empty
 func (v *Validator) InListIfFieldValNotEmpty(fieldVal string, fieldValDesc string, typeDesc string, validVals []string, baseErr error) bool {
 	switch {
-	case v.err != nil:
+	case v.halted():
 		return false
 
 	case fieldVal != "" && !goteamsnotify.InList(fieldVal, validVals, false):
@@ -286,6 +376,7 @@ func (v *Validator) InListIfFieldValNotEmpty(fieldVal string, fieldValDesc strin
 			)
 		}
 
+		v.fail(fieldError(fieldValDesc, RuleOneOf, fieldVal, v.err))
 		return false
 
 	// Validation is good.
@@ -351,7 +442,7 @@ func (v *Validator) InListIfFieldValNotEmpty(fieldVal string, fieldValDesc strin
 // returned if a prior validation step failed or if the items collection is
 // empty.
 func (v *Validator) NotEmptyCollection(fieldValueDesc string, typeDesc string, baseErr error, items ...interface{}) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
 	if len(items) == 0 {
@@ -371,6 +462,7 @@ func (v *Validator) NotEmptyCollection(fieldValueDesc string, typeDesc string, b
 			)
 		}
 
+		v.fail(fieldError(fieldValueDesc, RuleRequired, nil, v.err))
 		return false
 	}
 	return true
@@ -386,7 +478,7 @@ func (v *Validator) NotEmptyCollection(fieldValueDesc string, typeDesc string, b
 // (even if the collection itself has no values). A false value is returned if
 // a prior validation step failed or if any items in the collection are nil.
 func (v *Validator) NoNilValuesInCollection(fieldValueDesc string, typeDesc string, baseErr error, items ...interface{}) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
 
@@ -408,6 +500,7 @@ func (v *Validator) NoNilValuesInCollection(fieldValueDesc string, typeDesc stri
 			)
 		}
 
+		v.fail(fieldError(fieldValueDesc, RuleNoNilValues, nil, v.err))
 		return false
 
 	default:
@@ -433,7 +526,7 @@ func (v *Validator) NotEmptyCollectionIfFieldValNotEmpty(
 ) bool {
 
 	switch {
-	case v.err != nil:
+	case v.halted():
 		return false
 
 	case fieldVal != "" && len(items) == 0:
@@ -453,6 +546,7 @@ func (v *Validator) NotEmptyCollectionIfFieldValNotEmpty(
 			)
 		}
 
+		v.fail(fieldError(fieldValueDesc, RuleRequired, nil, v.err))
 		return false
 
 	default:
@@ -466,12 +560,12 @@ func (v *Validator) NotEmptyCollectionIfFieldValNotEmpty(
 // A true value is returned if fn was successful. A false value is returned if
 // a prior validation step failed or if fn returned an error.
 func (v *Validator) SuccessfulFuncCall(fn func() error) bool {
-	if v.err != nil {
+	if v.halted() {
 		return false
 	}
 
 	if err := fn(); err != nil {
-		v.err = err
+		v.fail(err)
 		return false
 	}
 
@@ -493,3 +587,9 @@ func (v *Validator) Error() string {
 func (v *Validator) Err() error {
 	return v.err
 }
+
+// Errs returns all recorded validation errors in the order recorded. Only
+// the first validation error is recorded unless ReportAll is set.
+func (v *Validator) Errs() []error {
+	return v.errs
+}
@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync/atomic"
 )
 
 /////////////////////////////////////////////////////////////////////////
@@ -481,9 +482,10 @@ type MessageCard struct {
 	// PotentialActions is a collection of actions for a MessageCard.
 	PotentialActions []*MessageCardPotentialAction `json:"potentialAction,omitempty"`
 
-	// payload is a prepared MessageCard in JSON format for submission or
-	// pretty printing.
-	payload *bytes.Buffer `json:"-"`
+	// payload holds the prepared MessageCard payload ([]byte) in JSON format for
+	// submission or pretty printing. The payload is stored atomically per
+	// value.
+	payload atomic.Value `json:"-"`
 }
 
 // validatePotentialAction inspects the given *MessageCardPotentialAction
@@ -616,7 +618,7 @@ func (mc *MessageCard) Validate() error {
 //
 // Deprecated: use (messagecard.MessageCard).Prepare instead.
 func (mc *MessageCard) Prepare() error {
-	jsonMessage, err := json.Marshal(mc)
+	payload, err := json.Marshal(mc)
 	if err != nil {
 		return fmt.Errorf(
 			"error marshalling MessageCard to JSON: %w",
@@ -624,20 +626,7 @@ func (mc *MessageCard) Prepare() error {
 		)
 	}
 
-	switch {
-	case mc.payload == nil:
-		mc.payload = &bytes.Buffer{}
-	default:
-		mc.payload.Reset()
-	}
-
-	_, err = mc.payload.Write(jsonMessage)
-	if err != nil {
-		return fmt.Errorf(
-			"error updating JSON payload for MessageCard: %w",
-			err,
-		)
-	}
+	mc.payload.Store(payload)
 
 	return nil
 }
@@ -647,7 +636,20 @@ func (mc *MessageCard) Prepare() error {
 //
 // Deprecated: use (messagecard.MessageCard).Payload instead.
 func (mc *MessageCard) Payload() io.Reader {
-	return mc.payload
+	payload := mc.preparedPayload()
+	if payload == nil {
+		return nil
+	}
+
+	return bytes.NewReader(payload)
+}
+
+// preparedPayload returns the payload recorded by Prepare or nil if the
+// MessageCard has not been prepared.
+func (mc *MessageCard) preparedPayload() []byte {
+	payload, _ := mc.payload.Load().([]byte)
+
+	return payload
 }
 
 // PrettyPrint returns a formatted JSON payload of the MessageCard if the
@@ -655,9 +657,9 @@ func (mc *MessageCard) Payload() io.Reader {
 //
 // Deprecated: use (messagecard.MessageCard).PrettyPrint instead.
 func (mc *MessageCard) PrettyPrint() string {
-	if mc.payload != nil {
+	if payload := mc.preparedPayload(); payload != nil {
 		var prettyJSON bytes.Buffer
-		_ = json.Indent(&prettyJSON, mc.payload.Bytes(), "", "\t")
+		_ = json.Indent(&prettyJSON, payload, "", "\t")
 
 		return prettyJSON.String()
 	}
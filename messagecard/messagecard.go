@@ -15,6 +15,9 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync/atomic"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
 )
 
 const (
@@ -405,15 +408,22 @@ type MessageCard struct {
 	// validation is performed.
 	ValidateFunc func() error `json:"-" yaml:"-"`
 
+	// DisableHTMLEscape controls whether the characters <, > and & are left
+	// as-is when generating the JSON payload for the MessageCard. By default
+	// these characters are escaped (e.g., "<" becomes "\u003c"). Disabling
+	// escaping results in smaller and more readable payloads.
+	DisableHTMLEscape bool `json:"-" yaml:"-"`
+
 	// Sections is a collection of sections to include in the card.
 	Sections []*Section `json:"sections,omitempty" yaml:"sections,omitempty"`
 
 	// PotentialActions is a collection of actions for a MessageCard.
 	PotentialActions []*PotentialAction `json:"potentialAction,omitempty" yaml:"potentialAction,omitempty"`
 
-	// payload is a prepared MessageCard in JSON format for submission or
-	// pretty printing.
-	payload *bytes.Buffer `json:"-" yaml:"-"`
+	// payload holds the prepared MessageCard payload ([]byte) in JSON format for
+	// submission or pretty printing. The payload is stored atomically per
+	// value.
+	payload atomic.Value `json:"-" yaml:"-"`
 }
 
 // validatePotentialAction inspects the given *PotentialAction
@@ -526,45 +536,63 @@ func (mc *MessageCard) Validate() error {
 
 // Prepare handles tasks needed to construct a payload from a MessageCard for
 // delivery to an endpoint.
+//
+// Prepare records the payload within the MessageCard and is safe for concurrent
+// use, e.g., when submitting the same MessageCard to several webhooks.
 func (mc *MessageCard) Prepare() error {
-	jsonMessage, err := json.Marshal(mc)
+	payload, err := mc.PayloadSnapshot()
 	if err != nil {
-		return fmt.Errorf(
-			"error marshalling MessageCard to JSON: %w",
-			err,
-		)
+		return err
 	}
 
-	switch {
-	case mc.payload == nil:
-		mc.payload = &bytes.Buffer{}
-	default:
-		mc.payload.Reset()
-	}
+	mc.payload.Store(payload)
 
-	_, err = mc.payload.Write(jsonMessage)
+	return nil
+}
+
+// PayloadSnapshot returns a newly generated JSON payload for the MessageCard
+// without modifying the MessageCard. The returned payload is not shared with
+// the MessageCard or other callers.
+func (mc *MessageCard) PayloadSnapshot() ([]byte, error) {
+	jsonMessage, err := jsonenc.Marshal(mc, !mc.DisableHTMLEscape)
 	if err != nil {
-		return fmt.Errorf(
-			"error updating JSON payload for MessageCard: %w",
+		return nil, fmt.Errorf(
+			"error marshalling MessageCard to JSON: %w",
 			err,
 		)
 	}
 
-	return nil
+	return jsonMessage, nil
 }
 
 // Payload returns the prepared MessageCard payload. The caller should call
 // Prepare() prior to calling this method, results are undefined otherwise.
+//
+// A new reader is returned for each call; reading from one does not affect
+// others.
 func (mc *MessageCard) Payload() io.Reader {
-	return mc.payload
+	payload := mc.preparedPayload()
+	if payload == nil {
+		return nil
+	}
+
+	return bytes.NewReader(payload)
+}
+
+// preparedPayload returns the payload recorded by Prepare or nil if the
+// MessageCard has not been prepared.
+func (mc *MessageCard) preparedPayload() []byte {
+	payload, _ := mc.payload.Load().([]byte)
+
+	return payload
 }
 
 // PrettyPrint returns a formatted JSON payload of the MessageCard if the
 // Prepare() method has been called, or an empty string otherwise.
 func (mc *MessageCard) PrettyPrint() string {
-	if mc.payload != nil {
+	if payload := mc.preparedPayload(); payload != nil {
 		var prettyJSON bytes.Buffer
-		_ = json.Indent(&prettyJSON, mc.payload.Bytes(), "", "\t")
+		_ = json.Indent(&prettyJSON, payload, "", "\t")
 
 		return prettyJSON.String()
 	}
@@ -0,0 +1,141 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package goteamsnotify
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RetryPolicy controls how many times (and how often) a TeamsClient retries
+// a failed message submission. The zero value disables retries.
+type RetryPolicy struct {
+	// Retries is the number of retry attempts made after the initial
+	// submission attempt fails.
+	Retries int
+
+	// Delay is the length of time to wait between submission attempts.
+	Delay time.Duration
+}
+
+// TeamsClientOption is a functional option used to configure a TeamsClient
+// at construction time. See NewTeamsClient.
+type TeamsClientOption func(*TeamsClient)
+
+// WithTimeout sets the timeout applied by the TeamsClient.Send method. If not
+// specified (or a non-positive value is given) DefaultWebhookSendTimeout is
+// used.
+func WithTimeout(timeout time.Duration) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.timeout = timeout
+	}
+}
+
+// WithUserAgent sets a custom user agent string used when submitting
+// messages to Microsoft Teams. If not specified DefaultUserAgent is used.
+func WithUserAgent(userAgent string) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHTTPClient sets a custom http.Client used when submitting messages to
+// Microsoft Teams. A nil value is ignored.
+func WithHTTPClient(httpClient *http.Client) TeamsClientOption {
+	return func(c *TeamsClient) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithWebhookURLValidationPatterns sets the patterns used to validate webhook
+// URLs, replacing the default patterns.
+func WithWebhookURLValidationPatterns(patterns ...string) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.webhookURLValidationPatterns = append([]string(nil), patterns...)
+	}
+}
+
+// WithWebhookURLValidationDisabled disables webhook URL validation.
+func WithWebhookURLValidationDisabled() TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.skipWebhookURLValidation = true
+	}
+}
+
+// WithRetryPolicy sets the retry policy applied by the TeamsClient.Send and
+// TeamsClient.SendWithContext methods. The TeamsClient.SendWithRetry method
+// continues to use the retry settings given by the caller.
+func WithRetryPolicy(policy RetryPolicy) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithLogger sets a logger used for troubleshooting output from the client
+// in place of the package-level logger. See also EnableLogging.
+func WithLogger(l *log.Logger) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.logger = l
+	}
+}
+
+// WithMaxResponseBodySize sets the maximum number of bytes read from the
+// response body returned by the remote endpoint. If not specified (or a
+// non-positive value is given) DefaultMaxResponseBodySize is used.
+func WithMaxResponseBodySize(size int64) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.maxResponseBodySize = size
+	}
+}
+
+// WithProxy routes message submissions through the given proxy server URL.
+//
+// The proxy setting is applied to a copy of the transport for the configured
+// http.Client once all other options have been applied. If a custom
+// http.Client with a transport other than *http.Transport is given, the
+// proxy setting is not applied.
+func WithProxy(proxyURL *url.URL) TeamsClientOption {
+	return func(c *TeamsClient) {
+		c.proxyURL = proxyURL
+	}
+}
+
+// applyProxy updates the http.Client for the TeamsClient to use the
+// configured proxy server URL. A copy of the http.Client and transport is
+// used so that caller provided values are not modified.
+func (c *TeamsClient) applyProxy() {
+	if c.proxyURL == nil {
+		return
+	}
+
+	var transport *http.Transport
+	switch t := c.httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		c.activeLogger().Printf(
+			"applyProxy: unsupported transport type %T; proxy host %q not applied\n",
+			t,
+			c.proxyURL.Host,
+		)
+
+		return
+	}
+
+	transport.Proxy = http.ProxyURL(c.proxyURL)
+
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package goteamsnotify
+
+import (
+	"net/http"
+	"time"
+)
+
+// Response headers provided by Power Automate workflow connectors which
+// allow tracking the workflow run triggered by a message submission.
+const (
+	WorkflowRunIDHeader      = "x-ms-workflow-run-id"
+	WorkflowNameHeader       = "x-ms-workflow-name"
+	WorkflowTrackingIDHeader = "x-ms-tracking-id"
+)
+
+// SendResult records details of a message submission to Microsoft Teams.
+// Response details (status, headers, body) are for the most recent attempt.
+type SendResult struct {
+	// StatusCode is the HTTP status code from the endpoint. This is zero if
+	// no response was received.
+	StatusCode int
+
+	// Status is the HTTP status text from the endpoint (e.g., "202
+	// Accepted").
+	Status string
+
+	// Header is the collection of HTTP response headers from the endpoint.
+	// For Workflow connectors this includes the workflow run tracking
+	// headers.
+	Header http.Header
+
+	// ResponseText is the response body from the endpoint.
+	ResponseText string
+
+	// Attempts is the number of submission attempts made.
+	Attempts int
+
+	// AttemptErrors is the collection of errors for each failed attempt, in
+	// the order that they occurred.
+	AttemptErrors []error
+
+	// Latency is the total time taken for the submission, including any
+	// retries and retry delays.
+	Latency time.Duration
+
+	// PayloadSize is the size in bytes of the final prepared message payload.
+	PayloadSize int
+}
+
+// WorkflowRunID returns the workflow run ID provided by a Workflow connector
+// endpoint or an empty string if not provided.
+func (sr SendResult) WorkflowRunID() string {
+	return sr.Header.Get(WorkflowRunIDHeader)
+}
+
+// Succeeded indicates whether the final submission attempt was successful.
+func (sr SendResult) Succeeded() bool {
+	return sr.Attempts > 0 && len(sr.AttemptErrors) < sr.Attempts
+}
@@ -9,6 +9,7 @@
 package goteamsnotify
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,7 +21,11 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/atc0005/go-teams-notify/v2/internal/jsonenc"
+	"github.com/atc0005/go-teams-notify/v2/internal/submission"
 )
 
 // logger is a package logger that can be enabled from client code to allow
@@ -73,6 +78,11 @@ const ExpectedWebhookURLResponseText string = "1"
 // before it times out and is cancelled.
 const DefaultWebhookSendTimeout = 5 * time.Second
 
+// DefaultMaxResponseBodySize is the maximum number of bytes read from the
+// response body returned by the remote endpoint when submitting messages.
+// Expected responses are small; any content beyond this limit is discarded.
+const DefaultMaxResponseBodySize int64 = 64 * 1024
+
 // DefaultUserAgent is the project-specific user agent used when submitting
 // messages unless overridden by client code. This replaces the Go default
 // user agent value of "Go-http-client/1.1".
@@ -91,6 +101,10 @@ var ErrWebhookURLUnexpected = errors.New("webhook URL does not match one of expe
 // Deprecated: Use ErrWebhookURLUnexpected instead.
 var ErrWebhookURLUnexpectedPrefix = ErrWebhookURLUnexpected
 
+// ErrNilMessage is returned when a nil message (including a nil pointer to
+// a message type) is submitted.
+var ErrNilMessage = errors.New("nil message")
+
 // ErrInvalidWebhookURLResponseText is returned when the remote webhook
 // endpoint indicates via response text that a message submission was
 // unsuccessful.
@@ -123,6 +137,19 @@ type MessageSender interface {
 	private()
 }
 
+// Sender describes the message submission behavior of a Microsoft Teams
+// client. Unlike MessageSender, this interface may be implemented by client
+// code; the intent is to allow substituting a test double (e.g., the
+// teamstest.FakeSender type) for a TeamsClient in unit tests.
+type Sender interface {
+	SendWithContext(ctx context.Context, webhookURL string, message TeamsMessage) error
+	SendWithRetry(ctx context.Context, webhookURL string, message TeamsMessage, retries int, retriesDelay int) error
+}
+
+// Add an "implements assertion" to fail the build if the TeamsClient type no
+// longer satisfies the Sender interface.
+var _ Sender = (*TeamsClient)(nil)
+
 // messagePreparer is a message type that supports marshaling its fields
 // as preparation for delivery to an endpoint.
 type messagePreparer interface {
@@ -154,11 +181,25 @@ type teamsClient struct {
 
 // TeamsClient provides functionality for submitting messages to a Microsoft
 // Teams channel.
+//
+// A TeamsClient is safe for concurrent use. Settings are provided at
+// construction time using TeamsClientOption values (see NewTeamsClient) or
+// using the setter methods. Each submission uses a copy of the settings
+// taken when it starts, so changes made by a setter apply to later
+// submissions only.
 type TeamsClient struct {
+	// mu guards all other fields.
+	mu sync.RWMutex
+
 	httpClient                   *http.Client
 	userAgent                    string
 	webhookURLValidationPatterns []string
 	skipWebhookURLValidation     bool
+	timeout                      time.Duration
+	retryPolicy                  RetryPolicy
+	logger                       *log.Logger
+	proxyURL                     *url.URL
+	maxResponseBodySize          int64
 }
 
 func init() {
@@ -196,9 +237,10 @@ func NewClient() API {
 	return &client
 }
 
-// NewTeamsClient constructs a minimal client for submitting messages to a
-// Microsoft Teams channel.
-func NewTeamsClient() *TeamsClient {
+// NewTeamsClient constructs a client for submitting messages to a Microsoft
+// Teams channel. If no options are specified a minimal client using default
+// settings is returned.
+func NewTeamsClient(options ...TeamsClientOption) *TeamsClient {
 	client := TeamsClient{
 		httpClient: &http.Client{
 			// We're using a context instead of setting this directly
@@ -206,6 +248,13 @@ func NewTeamsClient() *TeamsClient {
 		},
 		skipWebhookURLValidation: false,
 	}
+
+	for _, option := range options {
+		option(&client)
+	}
+
+	client.applyProxy()
+
 	return &client
 }
 
@@ -218,16 +267,24 @@ func (c *teamsClient) private() {}
 func (c *TeamsClient) private() {}
 
 // SetHTTPClient accepts a custom http.Client value which replaces the
-// existing default http.Client.
+// existing default http.Client. Submissions already in progress are not
+// affected.
 func (c *TeamsClient) SetHTTPClient(httpClient *http.Client) *TeamsClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.httpClient = httpClient
 
 	return c
 }
 
 // SetUserAgent accepts a custom user agent string. This custom user agent is
-// used when submitting messages to Microsoft Teams.
+// used when submitting messages to Microsoft Teams. Submissions already in
+// progress are not affected.
 func (c *TeamsClient) SetUserAgent(userAgent string) *TeamsClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.userAgent = userAgent
 
 	return c
@@ -249,6 +306,9 @@ func (c *teamsClient) UserAgent() string {
 // UserAgent returns the configured user agent string for the client. If a
 // custom value is not set the default package user agent is returned.
 func (c *TeamsClient) UserAgent() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	switch {
 	case c.userAgent != "":
 		return c.userAgent
@@ -267,9 +327,17 @@ func (c *teamsClient) AddWebhookURLValidationPatterns(patterns ...string) API {
 }
 
 // AddWebhookURLValidationPatterns collects given patterns for validation of
-// the webhook URL.
+// the webhook URL. Submissions already in progress are not affected.
 func (c *TeamsClient) AddWebhookURLValidationPatterns(patterns ...string) *TeamsClient {
-	c.webhookURLValidationPatterns = append(c.webhookURLValidationPatterns, patterns...)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Copy existing patterns to avoid modifying a collection shared with
+	// another caller.
+	updated := make([]string, 0, len(c.webhookURLValidationPatterns)+len(patterns))
+	updated = append(updated, c.webhookURLValidationPatterns...)
+	c.webhookURLValidationPatterns = append(updated, patterns...)
+
 	return c
 }
 
@@ -284,9 +352,63 @@ func (c *teamsClient) HTTPClient() *http.Client {
 // HTTPClient returns the internal pointer to an http.Client. This can be used
 // to further modify specific http.Client field values.
 func (c *TeamsClient) HTTPClient() *http.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.httpClient
 }
 
+// Timeout returns the timeout applied by the Send method. If a custom value
+// is not set DefaultWebhookSendTimeout is returned.
+func (c *TeamsClient) Timeout() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch {
+	case c.timeout > 0:
+		return c.timeout
+	default:
+		return DefaultWebhookSendTimeout
+	}
+}
+
+// RetryPolicy returns the retry policy applied by the Send and
+// SendWithContext methods.
+func (c *TeamsClient) RetryPolicy() RetryPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.retryPolicy
+}
+
+// MaxResponseBodySize returns the maximum number of bytes read from a
+// response body. If a custom value is not set DefaultMaxResponseBodySize is
+// returned.
+func (c *TeamsClient) MaxResponseBodySize() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch {
+	case c.maxResponseBodySize > 0:
+		return c.maxResponseBodySize
+	default:
+		return DefaultMaxResponseBodySize
+	}
+}
+
+// activeLogger returns the logger configured for the client, or the
+// package-level logger if one was not configured.
+func (c *TeamsClient) activeLogger() *log.Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.logger != nil {
+		return c.logger
+	}
+
+	return logger
+}
+
 // Send is a wrapper function around the SendWithContext method in order to
 // provide backwards compatibility.
 //
@@ -296,17 +418,18 @@ func (c *teamsClient) Send(webhookURL string, webhookMessage MessageCard) error
 	ctx, cancel := context.WithTimeout(context.Background(), DefaultWebhookSendTimeout)
 	defer cancel()
 
-	return sendWithContext(ctx, c, webhookURL, &webhookMessage)
+	return sendWithContext(ctx, c, webhookURL, &webhookMessage, &SendResult{})
 }
 
 // Send is a wrapper function around the SendWithContext method in order to
-// provide backwards compatibility.
+// provide backwards compatibility. The configured client timeout (see
+// WithTimeout) is applied.
 func (c *TeamsClient) Send(webhookURL string, message TeamsMessage) error {
 	// Create context that can be used to emulate existing timeout behavior.
-	ctx, cancel := context.WithTimeout(context.Background(), DefaultWebhookSendTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout())
 	defer cancel()
 
-	return sendWithContext(ctx, c, webhookURL, message)
+	return c.SendWithContext(ctx, webhookURL, message)
 }
 
 // SendWithContext submits a given message to a Microsoft Teams channel using
@@ -315,14 +438,31 @@ func (c *TeamsClient) Send(webhookURL string, message TeamsMessage) error {
 //
 // Deprecated: use TeamsClient.SendWithContext() method instead.
 func (c *teamsClient) SendWithContext(ctx context.Context, webhookURL string, webhookMessage MessageCard) error {
-	return sendWithContext(ctx, c, webhookURL, &webhookMessage)
+	return sendWithContext(ctx, c, webhookURL, &webhookMessage, &SendResult{})
 }
 
 // SendWithContext submits a given message to a Microsoft Teams channel using
 // the provided webhook URL. The http client request honors the cancellation
-// or timeout of the provided context.
+// or timeout of the provided context. The configured client retry policy
+// (see WithRetryPolicy) is applied.
+//
+// The message is prepared before submission, so its Payload and PrettyPrint
+// methods return the submitted payload afterwards. The same message may be
+// submitted from several goroutines concurrently.
 func (c *TeamsClient) SendWithContext(ctx context.Context, webhookURL string, message TeamsMessage) error {
-	return sendWithContext(ctx, c, webhookURL, message)
+	_, err := c.SendWithResult(ctx, webhookURL, message)
+
+	return err
+}
+
+// SendWithResult behaves like SendWithContext, but also returns details of
+// the submission such as the response status, headers and body, the number
+// of attempts made and the total latency. The result is returned even if the
+// submission fails.
+func (c *TeamsClient) SendWithResult(ctx context.Context, webhookURL string, message TeamsMessage) (SendResult, error) {
+	policy := c.RetryPolicy()
+
+	return c.send(ctx, webhookURL, message, policy.Retries, policy.Delay)
 }
 
 // SendWithRetry provides message retry support when submitting messages to a
@@ -331,14 +471,70 @@ func (c *TeamsClient) SendWithContext(ctx context.Context, webhookURL string, me
 //
 // Deprecated: use TeamsClient.SendWithRetry() method instead.
 func (c *teamsClient) SendWithRetry(ctx context.Context, webhookURL string, webhookMessage MessageCard, retries int, retriesDelay int) error {
-	return sendWithRetry(ctx, c, webhookURL, &webhookMessage, retries, retriesDelay)
+	return sendWithRetry(ctx, c, webhookURL, &webhookMessage, retries, time.Duration(retriesDelay)*time.Second, &SendResult{})
 }
 
 // SendWithRetry provides message retry support when submitting messages to a
 // Microsoft Teams channel. The caller is responsible for providing the
 // desired context timeout, the number of retries and retries delay.
 func (c *TeamsClient) SendWithRetry(ctx context.Context, webhookURL string, message TeamsMessage, retries int, retriesDelay int) error {
-	return sendWithRetry(ctx, c, webhookURL, message, retries, retriesDelay)
+	_, err := c.SendWithRetryResult(ctx, webhookURL, message, retries, retriesDelay)
+
+	return err
+}
+
+// SendWithRetryResult behaves like SendWithRetry, but also returns details of
+// the submission such as the response status, headers and body, the number
+// of attempts made, the error for each failed attempt and the total latency.
+// The result is returned even if the submission fails.
+func (c *TeamsClient) SendWithRetryResult(ctx context.Context, webhookURL string, message TeamsMessage, retries int, retriesDelay int) (SendResult, error) {
+	return c.send(ctx, webhookURL, message, retries, time.Duration(retriesDelay)*time.Second)
+}
+
+// send submits the given message, retrying as specified, and records the
+// details of the submission.
+func (c *TeamsClient) send(ctx context.Context, webhookURL string, message TeamsMessage, retries int, retriesDelay time.Duration) (SendResult, error) {
+	var result SendResult
+
+	// Use the settings in effect when the submission starts for all
+	// attempts.
+	c = c.snapshot()
+
+	start := time.Now()
+
+	var err error
+	switch {
+	case retries > 0:
+		err = sendWithRetry(ctx, c, webhookURL, message, retries, retriesDelay, &result)
+	default:
+		err = sendWithContext(ctx, c, webhookURL, message, &result)
+		if err != nil {
+			result.AttemptErrors = append(result.AttemptErrors, err)
+		}
+	}
+
+	result.Latency = time.Since(start)
+
+	return result, err
+}
+
+// snapshot returns a copy of the client which is unaffected by later changes
+// to the settings of the client.
+func (c *TeamsClient) snapshot() *TeamsClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return &TeamsClient{
+		httpClient:                   c.httpClient,
+		userAgent:                    c.userAgent,
+		webhookURLValidationPatterns: c.webhookURLValidationPatterns,
+		skipWebhookURLValidation:     c.skipWebhookURLValidation,
+		timeout:                      c.timeout,
+		retryPolicy:                  c.retryPolicy,
+		logger:                       c.logger,
+		proxyURL:                     c.proxyURL,
+		maxResponseBodySize:          c.maxResponseBodySize,
+	}
 }
 
 // SkipWebhookURLValidationOnSend allows the caller to optionally disable
@@ -351,8 +547,11 @@ func (c *teamsClient) SkipWebhookURLValidationOnSend(skip bool) API {
 }
 
 // SkipWebhookURLValidationOnSend allows the caller to optionally disable
-// webhook URL validation.
+// webhook URL validation. Submissions already in progress are not affected.
 func (c *TeamsClient) SkipWebhookURLValidationOnSend(skip bool) *TeamsClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.skipWebhookURLValidation = skip
 	return c
 }
@@ -373,17 +572,24 @@ func prepareRequest(ctx context.Context, userAgent string, webhookURL string, pr
 }
 
 // processResponse is a helper function responsible for validating a response
-// from an endpoint after submitting a message.
-func processResponse(response *http.Response) (string, error) {
+// from an endpoint after submitting a message. The response text is returned
+// along with any validation error.
+func processResponse(logger *log.Logger, response *http.Response, maxBodySize int64) (string, error) {
 	// Get the response body, then convert to string for use with extended
 	// error messages
-	responseData, err := ioutil.ReadAll(response.Body)
+	responseString, truncated, err := readResponseBody(response.Body, maxBodySize)
 	if err != nil {
 		logger.Println(err)
 
 		return "", err
 	}
-	responseString := string(responseData)
+
+	if truncated {
+		logger.Printf(
+			"processResponse: response body exceeds %d bytes; remaining content discarded\n",
+			maxBodySize,
+		)
+	}
 
 	// TODO: Refactor for v3 series once O365 connector support is dropped.
 	switch {
@@ -397,7 +603,7 @@ func processResponse(response *http.Response) (string, error) {
 
 		logger.Println(err)
 
-		return "", err
+		return responseString, err
 
 	case response.StatusCode == 202:
 		// 202 Accepted response is expected for Workflow connector URL
@@ -436,15 +642,38 @@ func processResponse(response *http.Response) (string, error) {
 
 		logger.Println(err)
 
-		return "", err
+		return responseString, err
 
 	default:
 		return responseString, nil
 	}
 }
 
+// readResponseBody reads at most maxBodySize bytes from the given response
+// body using a pooled buffer. A boolean value is returned indicating whether
+// the response body exceeded maxBodySize and was truncated.
+func readResponseBody(body io.Reader, maxBodySize int64) (string, bool, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxResponseBodySize
+	}
+
+	buf := jsonenc.GetBuffer()
+	defer jsonenc.PutBuffer(buf)
+
+	// Read one byte beyond the limit in order to detect truncation.
+	if _, err := buf.ReadFrom(io.LimitReader(body, maxBodySize+1)); err != nil {
+		return "", false, err
+	}
+
+	if int64(buf.Len()) > maxBodySize {
+		return string(buf.Bytes()[:maxBodySize]), true, nil
+	}
+
+	return buf.String(), false, nil
+}
+
 // validateWebhook applies webhook URL validation unless explicitly disabled.
-func validateWebhook(webhookURL string, skipWebhookValidation bool, patterns []string) error {
+func validateWebhook(logger *log.Logger, webhookURL string, skipWebhookValidation bool, patterns []string) error {
 	if skipWebhookValidation || webhookURL == DisableWebhookURLValidation {
 		logger.Printf("validateWebhook: Webhook URL will not be validated: %#v\n", webhookURL)
 
@@ -488,18 +717,55 @@ func validateWebhook(webhookURL string, skipWebhookValidation bool, patterns []s
 //
 // Deprecated: use TeamsClient.ValidateWebhook() method instead.
 func (c *teamsClient) ValidateWebhook(webhookURL string) error {
-	return validateWebhook(webhookURL, c.skipWebhookURLValidation, c.webhookURLValidationPatterns)
+	return validateWebhook(logger, webhookURL, c.skipWebhookURLValidation, c.webhookURLValidationPatterns)
 }
 
 // ValidateWebhook applies webhook URL validation unless explicitly disabled.
 func (c *TeamsClient) ValidateWebhook(webhookURL string) error {
-	return validateWebhook(webhookURL, c.skipWebhookURLValidation, c.webhookURLValidationPatterns)
+	c.mu.RLock()
+	skip := c.skipWebhookURLValidation
+	patterns := c.webhookURLValidationPatterns
+	c.mu.RUnlock()
+
+	return validateWebhook(c.activeLogger(), webhookURL, skip, patterns)
+}
+
+// clientMaxResponseBodySize returns the maximum response body size
+// configured for the given client, or DefaultMaxResponseBodySize if the client
+// does not support a custom value.
+func clientMaxResponseBodySize(client MessageSender) int64 {
+	if c, ok := client.(*TeamsClient); ok {
+		return c.MaxResponseBodySize()
+	}
+
+	return DefaultMaxResponseBodySize
+}
+
+// clientLogger returns the logger configured for the given client, or the
+// package-level logger if the client does not support a custom logger.
+func clientLogger(client MessageSender) *log.Logger {
+	if c, ok := client.(*TeamsClient); ok {
+		return c.activeLogger()
+	}
+
+	return logger
 }
 
 // sendWithContext submits a given message to a Microsoft Teams channel using
 // the provided webhook URL and client. The http client request honors the
 // cancellation or timeout of the provided context.
-func sendWithContext(ctx context.Context, client MessageSender, webhookURL string, message TeamsMessage) error {
+//
+// Details of the submission attempt are recorded in the given result.
+func sendWithContext(ctx context.Context, client MessageSender, webhookURL string, message TeamsMessage, result *SendResult) error {
+	logger := clientLogger(client)
+
+	// Response details are specific to the most recent attempt.
+	result.Attempts++
+	result.StatusCode = 0
+	result.Status = ""
+	result.Header = nil
+	result.ResponseText = ""
+
 	logger.Printf("sendWithContext: Webhook message received: %#v\n", message)
 
 	if err := client.ValidateWebhook(webhookURL); err != nil {
@@ -509,21 +775,30 @@ func sendWithContext(ctx context.Context, client MessageSender, webhookURL strin
 		)
 	}
 
-	if err := message.Validate(); err != nil {
+	if submission.IsNilMessage(message) {
+		return fmt.Errorf(
+			"failed to validate message: %w",
+			ErrNilMessage,
+		)
+	}
+
+	if err := submission.Validate(message); err != nil {
 		return fmt.Errorf(
 			"failed to validate message: %w",
 			err,
 		)
 	}
 
-	if err := message.Prepare(); err != nil {
+	payload, err := submission.Payload(message)
+	if err != nil {
 		return fmt.Errorf(
 			"failed to prepare message: %w",
 			err,
 		)
 	}
+	result.PayloadSize = len(payload)
 
-	req, err := prepareRequest(ctx, client.UserAgent(), webhookURL, message.Payload())
+	req, err := prepareRequest(ctx, client.UserAgent(), webhookURL, bytes.NewReader(payload))
 	if err != nil {
 		return fmt.Errorf(
 			"failed to prepare request: %w",
@@ -547,7 +822,12 @@ func sendWithContext(ctx context.Context, client MessageSender, webhookURL strin
 		}
 	}()
 
-	responseText, err := processResponse(res)
+	result.StatusCode = res.StatusCode
+	result.Status = res.Status
+	result.Header = res.Header
+
+	responseText, err := processResponse(logger, res, clientMaxResponseBodySize(client))
+	result.ResponseText = responseText
 	if err != nil {
 		return fmt.Errorf(
 			"failed to process response: %w",
@@ -563,7 +843,11 @@ func sendWithContext(ctx context.Context, client MessageSender, webhookURL strin
 // sendWithRetry provides message retry support when submitting messages to a
 // Microsoft Teams channel. The caller is responsible for providing the
 // desired context timeout, the number of retries and retries delay.
-func sendWithRetry(ctx context.Context, client MessageSender, webhookURL string, message TeamsMessage, retries int, retriesDelay int) error {
+//
+// Details of each submission attempt are recorded in the given result.
+func sendWithRetry(ctx context.Context, client MessageSender, webhookURL string, message TeamsMessage, retries int, retriesDelay time.Duration, sendResult *SendResult) error {
+	logger := clientLogger(client)
+
 	var result error
 
 	// initial attempt + number of specified retries
@@ -573,10 +857,11 @@ func sendWithRetry(ctx context.Context, client MessageSender, webhookURL string,
 	// times before giving up
 	for attempt := 1; attempt <= attemptsAllowed; attempt++ {
 		// the result from the last attempt is returned to the caller
-		result = sendWithContext(ctx, client, webhookURL, message)
+		result = sendWithContext(ctx, client, webhookURL, message, sendResult)
 
 		switch {
 		case result != nil:
+			sendResult.AttemptErrors = append(sendResult.AttemptErrors, result)
 
 			logger.Printf(
 				"sendWithRetry: Attempt %d of %d to send message failed: %v",
@@ -600,13 +885,30 @@ func sendWithRetry(ctx context.Context, client MessageSender, webhookURL string,
 				return errMsg
 			}
 
-			ourRetryDelay := time.Duration(retriesDelay) * time.Second
+			// No delay needed after the final attempt.
+			if attempt == attemptsAllowed {
+				break
+			}
 
 			logger.Printf(
 				"sendWithRetry: Context not cancelled yet, applying retry delay of %v",
-				ourRetryDelay,
+				retriesDelay,
 			)
-			time.Sleep(ourRetryDelay)
+
+			if err := sleepWithContext(ctx, retriesDelay); err != nil {
+				errMsg := fmt.Errorf(
+					"sendWithRetry: context cancelled or expired: %v; "+
+						"aborting message submission after %d of %d attempts: %w",
+					err.Error(),
+					attempt,
+					attemptsAllowed,
+					result,
+				)
+
+				logger.Println(errMsg)
+
+				return errMsg
+			}
 
 		default:
 			logger.Printf(
@@ -623,6 +925,25 @@ func sendWithRetry(ctx context.Context, client MessageSender, webhookURL string,
 	return result
 }
 
+// sleepWithContext pauses for the given duration or until the given context
+// is cancelled or expires, whichever occurs first. The context error is
+// returned if the context is done before the duration elapses.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // old deprecated helper functions --------------------------------------------------------------------------------------------------------------
 
 // IsValidInput is a validation "wrapper" function. This function is intended
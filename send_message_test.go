@@ -0,0 +1,120 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package goteamsnotify_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+	"github.com/atc0005/go-teams-notify/v2/adaptivecard"
+	"github.com/atc0005/go-teams-notify/v2/messagecard"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// sendConcurrently submits the given message to several webhook URLs from
+// several goroutines and asserts that every request includes the expected
+// payload.
+func sendConcurrently(t *testing.T, message goteamsnotify.TeamsMessage, expectedPayload []byte) {
+	t.Helper()
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			// Every request is expected to include the complete payload.
+			if !bytes.Equal(body, expectedPayload) {
+				return nil, errors.New("unexpected request body")
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBufferString("1")),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	// The message has not been prepared yet.
+	assert.Nil(t, message.Payload())
+
+	client := goteamsnotify.NewTeamsClient(goteamsnotify.WithHTTPClient(httpClient))
+
+	webhookURLs := []string{
+		"https://outlook.office.com/webhook/xxx",
+		"https://outlook.office365.com/webhook/yyy",
+		"https://example.webhook.office.com/webhook/zzz",
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(webhookURLs)*10)
+
+	for i := 0; i < 10; i++ {
+		for _, webhookURL := range webhookURLs {
+			wg.Add(1)
+			go func(webhookURL string) {
+				defer wg.Done()
+				errs <- client.SendWithRetry(context.Background(), webhookURL, message, 1, 0)
+			}(webhookURL)
+		}
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// The submitted payload remains recorded within the message.
+	payload, err := ioutil.ReadAll(message.Payload())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPayload, payload)
+}
+
+func TestTeamsClientConcurrentSendsOfSameAdaptiveCardMessage(t *testing.T) {
+	msg, err := adaptivecard.NewSimpleMessage("Hello <b>World</b>", "Greeting", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPayload, err := msg.PayloadSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sendConcurrently(t, msg, expectedPayload)
+}
+
+func TestTeamsClientConcurrentSendsOfSameMessageCard(t *testing.T) {
+	msgCard := messagecard.NewMessageCard()
+	msgCard.Title = "Greeting"
+	msgCard.Text = "Hello <b>World</b>"
+
+	expectedPayload, err := msgCard.PayloadSnapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sendConcurrently(t, msgCard, expectedPayload)
+}
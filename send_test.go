@@ -10,11 +10,16 @@ package goteamsnotify
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -276,6 +281,302 @@ func TestTeamsClientSend(t *testing.T) {
 
 }
 
+func TestNewTeamsClientOptions(t *testing.T) {
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient := &http.Client{}
+
+	client := NewTeamsClient(
+		WithTimeout(10*time.Second),
+		WithUserAgent("custom-agent/1.0"),
+		WithHTTPClient(httpClient),
+		WithWebhookURLValidationPatterns(`^https://arbitrary\.domain\.com`),
+		WithRetryPolicy(RetryPolicy{Retries: 2, Delay: time.Millisecond}),
+		WithLogger(log.New(ioutil.Discard, "", 0)),
+		WithProxy(proxyURL),
+	)
+
+	assert.Equal(t, 10*time.Second, client.Timeout())
+	assert.Equal(t, "custom-agent/1.0", client.UserAgent())
+	assert.Equal(t, RetryPolicy{Retries: 2, Delay: time.Millisecond}, client.RetryPolicy())
+	assert.NoError(t, client.ValidateWebhook("https://arbitrary.domain.com/webhook/xxx"))
+	assert.ErrorIs(t, client.ValidateWebhook("https://outlook.office.com/webhook/xxx"), ErrWebhookURLUnexpected)
+
+	// The caller provided http.Client is not modified when applying the
+	// proxy setting.
+	assert.Nil(t, httpClient.Transport)
+	if assert.IsType(t, &http.Transport{}, client.HTTPClient().Transport) {
+		transport := client.HTTPClient().Transport.(*http.Transport)
+		got, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "example.com"}})
+		assert.NoError(t, err)
+		assert.Equal(t, proxyURL, got)
+	}
+
+	defaultClient := NewTeamsClient()
+	assert.Equal(t, DefaultWebhookSendTimeout, defaultClient.Timeout())
+	assert.Equal(t, DefaultUserAgent, defaultClient.UserAgent())
+}
+
+func TestTeamsClientRetryPolicy(t *testing.T) {
+	simpleMsgCard := NewMessageCard()
+	simpleMsgCard.Text = "Hello World"
+
+	var mu sync.Mutex
+	var attempts int
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+
+		body := ExpectedWebhookURLResponseText
+		if attempts < 3 {
+			body = "failed"
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client := NewTeamsClient(
+		WithHTTPClient(httpClient),
+		WithRetryPolicy(RetryPolicy{Retries: 2, Delay: time.Millisecond}),
+	)
+
+	err := client.SendWithContext(context.Background(), "https://outlook.office.com/webhook/xxx", &simpleMsgCard)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestTeamsClientSendWithResult(t *testing.T) {
+	simpleMsgCard := NewMessageCard()
+	simpleMsgCard.Text = "Hello World"
+
+	var attempts int
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+
+		if attempts == 1 {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Status:     "429 Too Many Requests",
+				Body:       ioutil.NopCloser(bytes.NewBufferString("slow down")),
+				Header:     make(http.Header),
+			}, nil
+		}
+
+		header := make(http.Header)
+		header.Set(WorkflowRunIDHeader, "08584")
+
+		return &http.Response{
+			StatusCode: http.StatusAccepted,
+			Status:     "202 Accepted",
+			Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+			Header:     header,
+		}, nil
+	})
+
+	client := NewTeamsClient(WithHTTPClient(httpClient))
+
+	result, err := client.SendWithRetryResult(
+		context.Background(),
+		"https://outlook.office.com/webhook/xxx",
+		&simpleMsgCard,
+		1,
+		0,
+	)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, 2, result.Attempts)
+	assert.Len(t, result.AttemptErrors, 1)
+	assert.Equal(t, http.StatusAccepted, result.StatusCode)
+	assert.Equal(t, "08584", result.WorkflowRunID())
+	assert.Greater(t, result.PayloadSize, 0)
+	assert.Greater(t, int64(result.Latency), int64(0))
+
+	// Response details are recorded for failed submissions also.
+	attempts = 0
+	result, err = client.SendWithResult(
+		context.Background(),
+		"https://outlook.office.com/webhook/xxx",
+		&simpleMsgCard,
+	)
+
+	assert.Error(t, err)
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, "slow down", result.ResponseText)
+}
+
+func TestTeamsClientSendNilMessage(t *testing.T) {
+	client := NewTeamsClient()
+
+	var msg *MessageCard
+
+	for _, message := range []TeamsMessage{nil, msg} {
+		err := client.SendWithContext(context.Background(), "https://outlook.office.com/webhook/xxx", message)
+		assert.ErrorIs(t, err, ErrNilMessage)
+	}
+}
+
+func TestTeamsClientConcurrentSendsOfSameMessage(t *testing.T) {
+	msgCard := NewMessageCard()
+	msgCard.Text = "Hello World"
+
+	expectedMsgCard := NewMessageCard()
+	expectedMsgCard.Text = msgCard.Text
+	if err := expectedMsgCard.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPayload, err := ioutil.ReadAll(expectedMsgCard.Payload())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every request is expected to include the complete payload.
+		if !bytes.Equal(body, expectedPayload) {
+			return nil, errors.New("unexpected request body")
+		}
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(ExpectedWebhookURLResponseText)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client := NewTeamsClient(WithHTTPClient(httpClient))
+
+	webhookURLs := []string{
+		"https://outlook.office.com/webhook/xxx",
+		"https://outlook.office365.com/webhook/yyy",
+		"https://example.webhook.office.com/webhook/zzz",
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(webhookURLs)*10)
+
+	for i := 0; i < 10; i++ {
+		for _, webhookURL := range webhookURLs {
+			wg.Add(1)
+			go func(webhookURL string) {
+				defer wg.Done()
+				errs <- client.SendWithRetry(context.Background(), webhookURL, &msgCard, 1, 0)
+			}(webhookURL)
+		}
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// The submitted payload remains recorded within the message.
+	assert.NotEmpty(t, msgCard.PrettyPrint())
+
+	payload, err := ioutil.ReadAll(msgCard.Payload())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPayload, payload)
+}
+
+func TestTeamsClientSettingsFixedPerSubmission(t *testing.T) {
+	simpleMsgCard := NewMessageCard()
+	simpleMsgCard.Text = "Hello World"
+
+	var client *TeamsClient
+	var userAgents []string
+
+	httpClient := NewTestClient(func(req *http.Request) (*http.Response, error) {
+		userAgents = append(userAgents, req.Header.Get("User-Agent"))
+
+		// Change the settings while the submission is in progress.
+		client.SetUserAgent("changed-agent/1.0")
+
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("failed")),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	client = NewTeamsClient(WithHTTPClient(httpClient), WithUserAgent("custom-agent/1.0"))
+
+	err := client.SendWithRetry(context.Background(), "https://outlook.office.com/webhook/xxx", &simpleMsgCard, 1, 0)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"custom-agent/1.0", "custom-agent/1.0"}, userAgents)
+	assert.Equal(t, "changed-agent/1.0", client.UserAgent())
+}
+
+func TestTeamsClientZeroValueSetters(t *testing.T) {
+	var client TeamsClient
+
+	client.SetUserAgent("custom-agent/1.0").
+		AddWebhookURLValidationPatterns(DefaultWebhookURLValidationPattern).
+		SkipWebhookURLValidationOnSend(true)
+
+	assert.Equal(t, "custom-agent/1.0", client.UserAgent())
+	assert.Equal(t, DefaultWebhookSendTimeout, client.Timeout())
+	assert.NoError(t, client.ValidateWebhook("https://example.com/webhook"))
+}
+
+func TestReadResponseBodyLimit(t *testing.T) {
+	body := strings.Repeat("x", 100)
+
+	got, truncated, err := readResponseBody(strings.NewReader(body), 10)
+	assert.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, body[:10], got)
+
+	got, truncated, err = readResponseBody(strings.NewReader(body), 100)
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, body, got)
+}
+
+// BenchmarkReadResponseBodyUnbounded measures the response handling
+// approach previously used: an unbounded ioutil.ReadAll call.
+func BenchmarkReadResponseBodyUnbounded(b *testing.B) {
+	body := []byte(strings.Repeat("Summary or Text is required. ", 8))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, err := ioutil.ReadAll(bytes.NewReader(body))
+		if err != nil {
+			b.Fatal(err)
+		}
+		_ = string(data)
+	}
+}
+
+func BenchmarkReadResponseBody(b *testing.B) {
+	body := []byte(strings.Repeat("Summary or Text is required. ", 8))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := readResponseBody(bytes.NewReader(body), DefaultMaxResponseBodySize); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // helper for testing --------------------------------------------------------------------------------------------------
 
 // RoundTripFunc .
@@ -0,0 +1,22 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+/*
+Package teamstest provides utilities for testing client code which submits
+messages to Microsoft Teams using this library.
+
+The FakeSender type satisfies the goteamsnotify.Sender interface and records
+each message submitted to it in memory instead of delivering the message to a
+remote endpoint. Specific errors can be programmed in order to exercise error
+handling paths in client code.
+
+	sender := teamstest.NewFakeSender()
+	sender.QueueErrors(errors.New("temporary failure"))
+
+	// Pass sender to code expecting a goteamsnotify.Sender value.
+*/
+package teamstest
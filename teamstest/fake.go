@@ -0,0 +1,260 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package teamstest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+	"github.com/atc0005/go-teams-notify/v2/internal/submission"
+)
+
+// Add an "implements assertion" to fail the build if the FakeSender type no
+// longer satisfies the Sender interface.
+var _ goteamsnotify.Sender = (*FakeSender)(nil)
+
+// SentMessage is a record of a single message submission attempt made using
+// a FakeSender.
+type SentMessage struct {
+	// WebhookURL is the webhook URL provided for the submission attempt.
+	WebhookURL string
+
+	// Message is the message provided for the submission attempt.
+	Message goteamsnotify.TeamsMessage
+
+	// Payload is the prepared JSON payload for the message. This is empty if
+	// the message failed validation or preparation.
+	Payload []byte
+
+	// Attempt is the attempt number for this submission. This value is
+	// always 1 for messages submitted via SendWithContext.
+	Attempt int
+
+	// Err is the error returned to the caller for this submission attempt.
+	Err error
+}
+
+// FakeSender is an in-memory implementation of the goteamsnotify.Sender
+// interface intended for use in unit tests. Webhook URLs and messages are
+// validated and messages are prepared as they would be by a TeamsClient, but
+// are recorded instead of being submitted to a remote endpoint.
+//
+// FakeSender is safe for concurrent use.
+type FakeSender struct {
+	mu sync.Mutex
+
+	// client validates webhook URLs as configured by the options given to
+	// NewFakeSender.
+	client *goteamsnotify.TeamsClient
+
+	// sent is the collection of recorded submission attempts.
+	sent []SentMessage
+
+	// queuedErrs is a collection of errors returned (in order) for
+	// subsequent submission attempts.
+	queuedErrs []error
+
+	// err is returned for every submission attempt once queuedErrs has been
+	// exhausted.
+	err error
+
+	// skipValidation indicates whether message validation is skipped.
+	skipValidation bool
+}
+
+// NewFakeSender constructs a FakeSender which records all messages submitted
+// to it and reports success for each submission. Webhook URLs are validated
+// as by a TeamsClient constructed using the given options (e.g.,
+// goteamsnotify.WithWebhookURLValidationPatterns); options unrelated to
+// webhook URL validation have no effect.
+func NewFakeSender(options ...goteamsnotify.TeamsClientOption) *FakeSender {
+	return &FakeSender{
+		client: goteamsnotify.NewTeamsClient(options...),
+	}
+}
+
+// ReturnError sets the error returned for every submission attempt once any
+// queued errors have been exhausted. A nil value restores the default
+// behavior of reporting success.
+func (f *FakeSender) ReturnError(err error) *FakeSender {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+
+	return f
+}
+
+// QueueErrors records one or more errors returned (in the given order) for
+// subsequent submission attempts. A nil entry indicates a successful attempt.
+// This is useful for emulating transient failures when testing retry
+// behavior.
+func (f *FakeSender) QueueErrors(errs ...error) *FakeSender {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.queuedErrs = append(f.queuedErrs, errs...)
+
+	return f
+}
+
+// SkipValidation allows the caller to optionally disable message validation.
+func (f *FakeSender) SkipValidation(skip bool) *FakeSender {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.skipValidation = skip
+
+	return f
+}
+
+// SendWithContext records the given message. Validation errors for the
+// webhook URL and message and preparation errors for the message are
+// returned, otherwise the programmed error (if any) is returned. The
+// cancellation or timeout of the provided context is honored.
+func (f *FakeSender) SendWithContext(ctx context.Context, webhookURL string, message goteamsnotify.TeamsMessage) error {
+	return f.send(ctx, webhookURL, message, 1)
+}
+
+// SendWithRetry records the given message for each submission attempt,
+// retrying up to the specified number of times if a submission attempt
+// fails. Unlike a TeamsClient, the retries delay is not applied.
+func (f *FakeSender) SendWithRetry(ctx context.Context, webhookURL string, message goteamsnotify.TeamsMessage, retries int, retriesDelay int) error {
+	var result error
+
+	// initial attempt + number of specified retries
+	attemptsAllowed := 1 + retries
+
+	for attempt := 1; attempt <= attemptsAllowed; attempt++ {
+		result = f.send(ctx, webhookURL, message, attempt)
+		if result == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return fmt.Errorf(
+				"context cancelled or expired: %v; "+
+					"aborting message submission after %d of %d attempts: %w",
+				ctx.Err().Error(),
+				attempt,
+				attemptsAllowed,
+				result,
+			)
+		}
+	}
+
+	return result
+}
+
+// Messages returns a copy of all recorded submission attempts, including
+// failed attempts.
+func (f *FakeSender) Messages() []SentMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sent := make([]SentMessage, len(f.sent))
+	copy(sent, f.sent)
+
+	return sent
+}
+
+// Delivered returns a copy of all successful submission attempts.
+func (f *FakeSender) Delivered() []SentMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delivered := make([]SentMessage, 0, len(f.sent))
+	for _, sent := range f.sent {
+		if sent.Err == nil {
+			delivered = append(delivered, sent)
+		}
+	}
+
+	return delivered
+}
+
+// Reset discards all recorded submission attempts and programmed errors.
+func (f *FakeSender) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sent = nil
+	f.queuedErrs = nil
+	f.err = nil
+}
+
+// send records a single submission attempt for the given message.
+func (f *FakeSender) send(ctx context.Context, webhookURL string, message goteamsnotify.TeamsMessage, attempt int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	record := SentMessage{
+		WebhookURL: webhookURL,
+		Message:    message,
+		Attempt:    attempt,
+	}
+
+	record.Payload, record.Err = f.process(ctx, webhookURL, message)
+
+	f.sent = append(f.sent, record)
+
+	return record.Err
+}
+
+// process applies the same message handling performed by a TeamsClient and
+// returns the prepared payload or the error to report to the caller.
+func (f *FakeSender) process(ctx context.Context, webhookURL string, message goteamsnotify.TeamsMessage) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf(
+			"failed to submit message: %w",
+			err,
+		)
+	}
+
+	if err := f.client.ValidateWebhook(webhookURL); err != nil {
+		return nil, fmt.Errorf(
+			"failed to validate webhook URL: %w",
+			err,
+		)
+	}
+
+	if submission.IsNilMessage(message) {
+		return nil, fmt.Errorf(
+			"failed to validate message: %w",
+			goteamsnotify.ErrNilMessage,
+		)
+	}
+
+	if !f.skipValidation {
+		if err := submission.Validate(message); err != nil {
+			return nil, fmt.Errorf(
+				"failed to validate message: %w",
+				err,
+			)
+		}
+	}
+
+	payload, err := submission.Payload(message)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to prepare message: %w",
+			err,
+		)
+	}
+
+	if len(f.queuedErrs) > 0 {
+		err := f.queuedErrs[0]
+		f.queuedErrs = f.queuedErrs[1:]
+
+		return payload, err
+	}
+
+	return payload, f.err
+}
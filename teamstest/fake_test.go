@@ -0,0 +1,123 @@
+// Copyright 2026 Adam Chalkley
+//
+// https://github.com/atc0005/go-teams-notify
+//
+// Licensed under the MIT License. See LICENSE file in the project root for
+// full license information.
+
+package teamstest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	goteamsnotify "github.com/atc0005/go-teams-notify/v2"
+	"github.com/atc0005/go-teams-notify/v2/adaptivecard"
+)
+
+const testWebhookURL = "https://example.webhook.office.com/webhook/xxx"
+
+func TestFakeSenderRecordsMessages(t *testing.T) {
+	msg, err := adaptivecard.NewSimpleMessage("Hello World", "", true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	sender := NewFakeSender()
+
+	err = sender.SendWithContext(context.Background(), testWebhookURL, msg)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	sent := sender.Messages()
+	if !assert.Len(t, sent, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, testWebhookURL, sent[0].WebhookURL)
+	assert.Equal(t, 1, sent[0].Attempt)
+	assert.Contains(t, string(sent[0].Payload), "Hello World")
+	assert.Len(t, sender.Delivered(), 1)
+}
+
+func TestFakeSenderReturnsProgrammedErrors(t *testing.T) {
+	msg, err := adaptivecard.NewSimpleMessage("Hello World", "", true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	errTransient := errors.New("transient failure")
+	errPermanent := errors.New("permanent failure")
+
+	sender := NewFakeSender().
+		QueueErrors(errTransient, nil).
+		ReturnError(errPermanent)
+
+	err = sender.SendWithRetry(context.Background(), testWebhookURL, msg, 2, 1)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	sent := sender.Messages()
+	if !assert.Len(t, sent, 2) {
+		t.FailNow()
+	}
+	assert.ErrorIs(t, sent[0].Err, errTransient)
+	assert.Equal(t, 2, sent[1].Attempt)
+
+	err = sender.SendWithContext(context.Background(), testWebhookURL, msg)
+	assert.ErrorIs(t, err, errPermanent)
+	assert.Len(t, sender.Delivered(), 1)
+
+	sender.Reset()
+	assert.Empty(t, sender.Messages())
+}
+
+func TestFakeSenderValidatesMessages(t *testing.T) {
+	sender := NewFakeSender()
+
+	msg := adaptivecard.NewMessage()
+	msg.Type = "invalid"
+
+	err := sender.SendWithContext(context.Background(), testWebhookURL, msg)
+	assert.ErrorIs(t, err, adaptivecard.ErrInvalidType)
+	assert.Empty(t, sender.Delivered())
+}
+
+func TestFakeSenderRejectsNilMessages(t *testing.T) {
+	sender := NewFakeSender()
+
+	var msg *adaptivecard.Message
+
+	for _, message := range []goteamsnotify.TeamsMessage{nil, msg} {
+		err := sender.SendWithContext(context.Background(), testWebhookURL, message)
+		assert.ErrorIs(t, err, goteamsnotify.ErrNilMessage)
+	}
+
+	assert.Len(t, sender.Messages(), 2)
+	assert.Empty(t, sender.Delivered())
+}
+
+func TestFakeSenderValidatesWebhookURLs(t *testing.T) {
+	msg, err := adaptivecard.NewSimpleMessage("Hello World", "", true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	const invalidURL = "https://example.com/webhook"
+
+	sender := NewFakeSender()
+
+	err = sender.SendWithContext(context.Background(), invalidURL, msg)
+	assert.ErrorIs(t, err, goteamsnotify.ErrWebhookURLUnexpected)
+	assert.Empty(t, sender.Delivered())
+
+	// The TeamsClient options for webhook URL validation are honored.
+	sender = NewFakeSender(goteamsnotify.WithWebhookURLValidationPatterns(`^https://example\.com/`))
+
+	assert.NoError(t, sender.SendWithContext(context.Background(), invalidURL, msg))
+	assert.Len(t, sender.Delivered(), 1)
+}